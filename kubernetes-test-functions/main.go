@@ -7,16 +7,46 @@
 package kubernetes_test_functions
 
 import (
+	"fmt"
+	"io"
+	v1admissionregistration "k8s.io/api/admissionregistration/v1"
 	v1 "k8s.io/api/apps/v1"
+	v1batch "k8s.io/api/batch/v1"
+	v1beta1batch "k8s.io/api/batch/v1beta1"
 	v1core "k8s.io/api/core/v1"
+	v1beta1discovery "k8s.io/api/discovery/v1beta1"
+	v1storage "k8s.io/api/storage/v1"
+	v1apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	v1meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"reflect"
 	"regexp"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
+// TestingT is the subset of *testing.T used to report the results of assertions.  *testing.T satisfies it, as do the
+// test types of other frameworks, so the functions in this module can be used outside the standard test runner.
+type TestingT interface {
+	Logf(format string, args ...interface{})
+	Errorf(format string, args ...interface{})
+	Fatalf(format string, args ...interface{})
+}
+
 // ExpectedDeploymentCount determines if the number of 'Deployment' objects in a namespace is as expected.
-func ExpectedDeploymentCount(t *testing.T, clientset *kubernetes.Clientset, namespace string, expectedCount int) {
+func ExpectedDeploymentCount(t TestingT, clientset kubernetes.Interface, namespace string, expectedCount int) {
 	deployments, err := clientset.AppsV1().Deployments(namespace).List(v1meta.ListOptions{})
 
 	if err != nil {
@@ -42,7 +72,7 @@ func ExpectedDeploymentCount(t *testing.T, clientset *kubernetes.Clientset, name
 }
 
 // DeploymentExists checks if a Deployment object exists in a certain namespace.
-func DeploymentExists(t *testing.T, clientset *kubernetes.Clientset, name string, namespace string)  {
+func DeploymentExists(t TestingT, clientset kubernetes.Interface, name string, namespace string)  {
 	deployment, err := clientset.AppsV1().Deployments(namespace).Get(name, v1meta.GetOptions{})
 
 	if err != nil {
@@ -59,7 +89,7 @@ func DeploymentExists(t *testing.T, clientset *kubernetes.Clientset, name string
 
 // AnnotationsEqual logs a failure to a test suite if an annotation in the annotations map does not have its expected
 // value.  Otherwise, it logs a success message and the test suite will proceed with a success code.
-func AnnotationsEqual(t *testing.T, annotations map[string]string, name string, expectedValue string) {
+func AnnotationsEqual(t TestingT, annotations map[string]string, name string, expectedValue string) {
 	value := annotations[name]
 
 	if expectedValue == value {
@@ -81,7 +111,7 @@ func AnnotationsEqual(t *testing.T, annotations map[string]string, name string,
 
 // AnnotationsMatchPattern logs a failure to a test suite if an annotation in the annotations map does not match its
 // expected pattern.  Otherwise, it logs a success message and the test suite will proceed with a success code.
-func AnnotationsMatchPattern(t *testing.T, annotations map[string]string, name string, expectedPattern string) {
+func AnnotationsMatchPattern(t TestingT, annotations map[string]string, name string, expectedPattern string) {
 	value := annotations[name]
 	pattern, err := regexp.Compile(expectedPattern)
 
@@ -106,8 +136,104 @@ func AnnotationsMatchPattern(t *testing.T, annotations map[string]string, name s
 	}
 }
 
+// AnnotationAbsent logs a failure to a test suite if an annotation exists in the annotations map, even if its value is
+// empty.  Otherwise, it logs a success message and the test suite will proceed with a success code.
+func AnnotationAbsent(t TestingT, annotations map[string]string, name string) {
+	value, exists := annotations[name]
+
+	if !exists {
+		t.Logf("Annotation %v does not exist as expected.", name)
+	} else {
+		t.Errorf("Annotation %v exists when it was expected to be absent.  Got %v.", name, value)
+	}
+}
+
+// AnnotationsAllEqual logs a single failure to a test suite listing every annotation in the expected map which does
+// not have its expected value in the annotations map.  Otherwise, it logs a success message and the test suite will
+// proceed with a success code.
+func AnnotationsAllEqual(t TestingT, annotations map[string]string, expected map[string]string) {
+	names := make([]string, 0, len(expected))
+	for name := range expected {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	mismatches := make([]string, 0)
+	for _, name := range names {
+		value, exists := annotations[name]
+
+		if !exists {
+			mismatches = append(mismatches, fmt.Sprintf("%v (expected %v, got nothing)", name, expected[name]))
+		} else if value != expected[name] {
+			mismatches = append(mismatches, fmt.Sprintf("%v (expected %v, got %v)", name, expected[name], value))
+		}
+	}
+
+	if len(mismatches) == 0 {
+		t.Logf("All %v annotations exist with their expected values.", len(expected))
+	} else {
+		t.Errorf(
+			"%v of %v annotations do not exist with their expected values: %v.",
+			len(mismatches),
+			len(expected),
+			strings.Join(mismatches, ", "),
+		)
+	}
+}
+
+// AnnotationsExactly logs a single failure to a test suite listing every annotation which is missing, has the wrong
+// value, or is not in the expected map.  Unlike AnnotationsAllEqual, unexpected annotations cause a failure.
+// Otherwise, it logs a success message and the test suite will proceed with a success code.
+func AnnotationsExactly(t TestingT, annotations map[string]string, expected map[string]string) {
+	differences := stringMapDifferences(annotations, expected)
+
+	if len(differences) == 0 {
+		t.Logf("Annotations exactly match the %v expected annotations.", len(expected))
+	} else {
+		t.Errorf(
+			"Annotations do not exactly match the expected annotations.  Differences: %v.",
+			strings.Join(differences, ", "),
+		)
+	}
+}
+
+// AnnotationsAddedExactly logs a failure to a test suite if the annotations added or changed between a snapshot of an
+// object taken before an operation and one taken after it are not exactly the expected annotations.  Annotations
+// removed by the operation also cause a failure.  Otherwise, it logs a success message and the test suite will proceed
+// with a success code.
+func AnnotationsAddedExactly(
+	t TestingT,
+	before map[string]string,
+	after map[string]string,
+	expectedAdded map[string]string,
+) {
+	added := make(map[string]string)
+	for name, value := range after {
+		if beforeValue, exists := before[name]; !exists || beforeValue != value {
+			added[name] = value
+		}
+	}
+
+	differences := stringMapDifferences(added, expectedAdded)
+	for name, value := range before {
+		if _, exists := after[name]; !exists {
+			differences = append(differences, fmt.Sprintf("%v was removed (had %v)", name, value))
+		}
+	}
+	sort.Strings(differences)
+
+	if len(differences) == 0 {
+		t.Logf("Exactly the %v expected annotations were added.", len(expectedAdded))
+	} else {
+		t.Errorf(
+			"The added annotations do not exactly match the expected annotations.  Differences: %v.",
+			strings.Join(differences, ", "),
+		)
+	}
+}
+
 // ConditionStatusMet checks a condition on a Deployment and sees if its status is as expected.
-func ConditionStatusMet(t *testing.T, conditions []v1.DeploymentCondition,
+func ConditionStatusMet(t TestingT, conditions []v1.DeploymentCondition,
 	conditionType v1.DeploymentConditionType, expectedStatus v1core.ConditionStatus) {
 
 	matches := make([]v1.DeploymentCondition, 0, 1)
@@ -117,6 +243,11 @@ func ConditionStatusMet(t *testing.T, conditions []v1.DeploymentCondition,
 		}
 	}
 
+	if len(matches) == 0 {
+		t.Errorf("Condition type %v not found on Deployment.  Expected %v.", conditionType, expectedStatus)
+		return
+	}
+
 	status := matches[0].Status
 
 	if status == expectedStatus {
@@ -138,7 +269,7 @@ func ConditionStatusMet(t *testing.T, conditions []v1.DeploymentCondition,
 
 // ReplicaCountAsExpected performs appropriate logging when comparing the number of replicas for a deployment and its 
 // expected value.
-func ReplicaCountAsExpected(t *testing.T, expectedReplicas int32, actualReplicas int32, description string)  {
+func ReplicaCountAsExpected(t TestingT, expectedReplicas int32, actualReplicas int32, description string)  {
 	if expectedReplicas == actualReplicas {
 		t.Logf(
 			"Jenkins Deployment has expected %v.  Expected %v, got %v.",
@@ -159,8 +290,8 @@ func ReplicaCountAsExpected(t *testing.T, expectedReplicas int32, actualReplicas
 // DeploymentStatusCheck determines if a Deployment object is running as expected.  Commonly used to make sure there
 // aren't any errors in the Deployment.
 func DeploymentStatusCheck(
-	t *testing.T,
-	clientset *kubernetes.Clientset,
+	t TestingT,
+	clientset kubernetes.Interface,
 	name string,
 	namespace string,
 	isAvailable bool,
@@ -208,8 +339,120 @@ func DeploymentStatusCheck(
 	ReplicaCountAsExpected(t, expectedUnavailableReplicas, unavailableReplicas, "number of unavailable replicas")
 }
 
+// DeploymentStrategyTypeEquals determines if a Deployment uses the expected strategy to replace existing pods with new
+// ones, such as 'RollingUpdate' or 'Recreate'.
+func DeploymentStrategyTypeEquals(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	expected v1.DeploymentStrategyType,
+) {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	strategyType := deployment.Spec.Strategy.Type
+	if strategyType == expected {
+		t.Logf(
+			"Deployment '%v' has the expected strategy type.  Expected %v, got %v.",
+			name,
+			expected,
+			strategyType,
+		)
+	} else {
+		t.Errorf(
+			"Deployment '%v' does not have the expected strategy type.  Expected %v, got %v.",
+			name,
+			expected,
+			strategyType,
+		)
+	}
+}
+
+// DeploymentRollingUpdateParams determines if a Deployment using the 'RollingUpdate' strategy has the expected
+// maxSurge and maxUnavailable values.
+func DeploymentRollingUpdateParams(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	expectedMaxSurge intstr.IntOrString,
+	expectedMaxUnavailable intstr.IntOrString,
+) {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	rollingUpdate := deployment.Spec.Strategy.RollingUpdate
+	if rollingUpdate == nil {
+		t.Errorf(
+			"Deployment '%v' does not have rolling update parameters.  Strategy type is %v.",
+			name,
+			deployment.Spec.Strategy.Type,
+		)
+		return
+	}
+
+	intOrStringAsExpected(t, expectedMaxSurge, rollingUpdate.MaxSurge, "Deployment", name, "maxSurge")
+	intOrStringAsExpected(
+		t,
+		expectedMaxUnavailable,
+		rollingUpdate.MaxUnavailable,
+		"Deployment",
+		name,
+		"maxUnavailable",
+	)
+}
+
+// intOrStringAsExpected performs appropriate logging when comparing an optional integer or percentage value on an
+// object to its expected value.
+func intOrStringAsExpected(
+	t TestingT,
+	expected intstr.IntOrString,
+	actual *intstr.IntOrString,
+	kind string,
+	name string,
+	description string,
+) {
+	if actual == nil {
+		t.Errorf(
+			"%v '%v' does not have a %v value.  Expected %v, got unset.",
+			kind,
+			name,
+			description,
+			expected.String(),
+		)
+		return
+	}
+
+	if *actual == expected {
+		t.Logf(
+			"%v '%v' has the expected %v.  Expected %v, got %v.",
+			kind,
+			name,
+			description,
+			expected.String(),
+			actual.String(),
+		)
+	} else {
+		t.Errorf(
+			"%v '%v' has an unexpected %v.  Expected %v, got %v.",
+			kind,
+			name,
+			description,
+			expected.String(),
+			actual.String(),
+		)
+	}
+}
+
 // NamespaceExists determines if a Namespace exists and is active in a cluster.
-func NamespaceExists(t *testing.T, clientset *kubernetes.Clientset, name string) {
+func NamespaceExists(t TestingT, clientset kubernetes.Interface, name string) {
 	namespace, err := clientset.CoreV1().Namespaces().Get(name, v1meta.GetOptions{})
 
 	if err != nil {
@@ -224,8 +467,57 @@ func NamespaceExists(t *testing.T, clientset *kubernetes.Clientset, name string)
 	}
 }
 
+// WaitForNamespaceDeleted polls a cluster until a Namespace no longer exists.  Namespace deletion is asynchronous, so
+// a Namespace can remain in the 'Terminating' phase while its finalizers run.
+func WaitForNamespaceDeleted(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	retries int,
+	interval time.Duration,
+) {
+	var namespace *v1core.Namespace
+
+	for attempt := 1; attempt <= retries; attempt++ {
+		var err error
+		namespace, err = clientset.CoreV1().Namespaces().Get(name, v1meta.GetOptions{})
+
+		if k8serrors.IsNotFound(err) {
+			t.Logf("Namespace '%v' was deleted after %v attempt(s).", name, attempt)
+			return
+		}
+
+		if err != nil {
+			panic(err.Error())
+		}
+
+		if attempt < retries {
+			time.Sleep(interval)
+		}
+	}
+
+	if namespace == nil {
+		t.Errorf("Namespace '%v' was not confirmed deleted.  No attempts were made.", name)
+		return
+	}
+
+	finalizers := make([]string, 0, len(namespace.Spec.Finalizers)+len(namespace.Finalizers))
+	for _, finalizer := range namespace.Spec.Finalizers {
+		finalizers = append(finalizers, string(finalizer))
+	}
+	finalizers = append(finalizers, namespace.Finalizers...)
+
+	t.Errorf(
+		"Namespace '%v' still exists after %v attempts.  Phase %v, remaining finalizers %v.",
+		name,
+		retries,
+		namespace.Status.Phase,
+		finalizers,
+	)
+}
+
 // ServiceAccountExists determines if a ServiceAccount exists in a cluster.
-func ServiceAccountExists(t *testing.T, clientset *kubernetes.Clientset, name string, namespace string) {
+func ServiceAccountExists(t TestingT, clientset kubernetes.Interface, name string, namespace string) {
 	serviceAccount, err := clientset.CoreV1().ServiceAccounts(namespace).Get(name, v1meta.GetOptions{})
 
 	if err != nil {
@@ -241,7 +533,7 @@ func ServiceAccountExists(t *testing.T, clientset *kubernetes.Clientset, name st
 }
 
 // RoleExists determines if a Role exists in a cluster in a specific namespace.
-func RoleExists(t *testing.T, clientset *kubernetes.Clientset, name string, namespace string) {
+func RoleExists(t TestingT, clientset kubernetes.Interface, name string, namespace string) {
 	role, err := clientset.RbacV1().Roles(namespace).Get(name, v1meta.GetOptions{})
 
 	if err != nil {
@@ -257,7 +549,7 @@ func RoleExists(t *testing.T, clientset *kubernetes.Clientset, name string, name
 }
 
 // RoleBindingExists tests that a RoleBinding object with a given name exists in a specific namespace.
-func RoleBindingExists(t *testing.T, clientset *kubernetes.Clientset, name string, namespace string)  {
+func RoleBindingExists(t TestingT, clientset kubernetes.Interface, name string, namespace string)  {
 	role, err := clientset.RbacV1().RoleBindings(namespace).Get(name, v1meta.GetOptions{})
 
 	if err != nil {
@@ -273,7 +565,7 @@ func RoleBindingExists(t *testing.T, clientset *kubernetes.Clientset, name strin
 }
 
 // ClusterRoleExists tests that a ClusterRole object with a given name exists.
-func ClusterRoleExists(t *testing.T, clientset *kubernetes.Clientset, name string) {
+func ClusterRoleExists(t TestingT, clientset kubernetes.Interface, name string) {
 	role, err := clientset.RbacV1().ClusterRoles().Get(name, v1meta.GetOptions{})
 
 	if err != nil {
@@ -289,7 +581,7 @@ func ClusterRoleExists(t *testing.T, clientset *kubernetes.Clientset, name strin
 }
 
 // ClusterRoleBindingExists tests that a ClusterRoleBinding object with a given name exists.
-func ClusterRoleBindingExists(t *testing.T, clientset *kubernetes.Clientset, name string)  {
+func ClusterRoleBindingExists(t TestingT, clientset kubernetes.Interface, name string)  {
 	role, err := clientset.RbacV1().ClusterRoleBindings().Get(name, v1meta.GetOptions{})
 
 	if err != nil {
@@ -305,7 +597,7 @@ func ClusterRoleBindingExists(t *testing.T, clientset *kubernetes.Clientset, nam
 }
 
 // NamespaceServiceCount determines if the expected number of Service objects exist in the a namespace.
-func NamespaceServiceCount(t *testing.T, clientset *kubernetes.Clientset, namespace string, expectedServiceCount int) {
+func NamespaceServiceCount(t TestingT, clientset kubernetes.Interface, namespace string, expectedServiceCount int) {
 	services, err := clientset.CoreV1().Services(namespace).List(v1meta.ListOptions{})
 
 	if err != nil {
@@ -332,8 +624,8 @@ func NamespaceServiceCount(t *testing.T, clientset *kubernetes.Clientset, namesp
 
 // ServiceExists determines if a Service exists in the a specific namespace.
 func ServiceExists(
-	t *testing.T,
-	clientset *kubernetes.Clientset,
+	t TestingT,
+	clientset kubernetes.Interface,
 	name string,
 	namespace string,
 	serviceType v1core.ServiceType,
@@ -362,7 +654,7 @@ func ServiceExists(
 }
 
 // NamespaceIngressCount determines if the number of 'Ingress' objects in a namespace is as expected.
-func NamespaceIngressCount(t *testing.T, clientset *kubernetes.Clientset, namespace string, expectedIngressCount int) {
+func NamespaceIngressCount(t TestingT, clientset kubernetes.Interface, namespace string, expectedIngressCount int) {
 	ingresses, err := clientset.NetworkingV1beta1().Ingresses(namespace).List(v1meta.ListOptions{})
 
 	if err != nil {
@@ -388,7 +680,7 @@ func NamespaceIngressCount(t *testing.T, clientset *kubernetes.Clientset, namesp
 }
 
 // IngressExists determines if an ingress object exists in a specific namespace.
-func IngressExists(t *testing.T, clientset *kubernetes.Clientset, namespace string, name string) {
+func IngressExists(t TestingT, clientset kubernetes.Interface, namespace string, name string) {
 	ingress, err := clientset.NetworkingV1beta1().Ingresses(namespace).Get(name, v1meta.GetOptions{})
 
 	if err != nil {
@@ -409,3 +701,4005 @@ func IngressExists(t *testing.T, clientset *kubernetes.Clientset, namespace stri
 		)
 	}
 }
+
+// ContainerHasLivenessProbe determines if a container in a Deployment's pod template has a liveness probe.
+func ContainerHasLivenessProbe(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	containerName string,
+) {
+	container := getDeploymentContainer(t, clientset, name, namespace, containerName)
+
+	if container != nil {
+		probeExists(t, container.LivenessProbe, containerName, "liveness")
+	}
+}
+
+// ContainerHasReadinessProbe determines if a container in a Deployment's pod template has a readiness probe.
+func ContainerHasReadinessProbe(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	containerName string,
+) {
+	container := getDeploymentContainer(t, clientset, name, namespace, containerName)
+
+	if container != nil {
+		probeExists(t, container.ReadinessProbe, containerName, "readiness")
+	}
+}
+
+// ContainerLivenessProbeHTTPGet determines if a container in a Deployment's pod template has a liveness probe which
+// sends HTTP GET requests to the expected path and port.
+func ContainerLivenessProbeHTTPGet(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	containerName string,
+	expectedPath string,
+	expectedPort intstr.IntOrString,
+) {
+	container := getDeploymentContainer(t, clientset, name, namespace, containerName)
+
+	if container != nil {
+		probeHTTPGetAsExpected(t, container.LivenessProbe, containerName, "liveness", expectedPath, expectedPort)
+	}
+}
+
+// ContainerReadinessProbeHTTPGet determines if a container in a Deployment's pod template has a readiness probe which
+// sends HTTP GET requests to the expected path and port.
+func ContainerReadinessProbeHTTPGet(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	containerName string,
+	expectedPath string,
+	expectedPort intstr.IntOrString,
+) {
+	container := getDeploymentContainer(t, clientset, name, namespace, containerName)
+
+	if container != nil {
+		probeHTTPGetAsExpected(t, container.ReadinessProbe, containerName, "readiness", expectedPath, expectedPort)
+	}
+}
+
+// getDeploymentContainer retrieves a container with a given name from a Deployment's pod template.  If the container
+// doesn't exist, a failure is logged and nil is returned.
+func getDeploymentContainer(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	containerName string,
+) *v1core.Container {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	container := findContainer(deployment.Spec.Template.Spec.Containers, containerName)
+
+	if container == nil {
+		t.Errorf("Deployment '%v' does not have a container named '%v'.", name, containerName)
+	}
+
+	return container
+}
+
+// findContainer returns the container with a given name from a list of containers, or nil if it doesn't exist.
+func findContainer(containers []v1core.Container, containerName string) *v1core.Container {
+	for i := range containers {
+		if containers[i].Name == containerName {
+			return &containers[i]
+		}
+	}
+
+	return nil
+}
+
+// probeExists performs appropriate logging when checking whether a container defines a probe.
+func probeExists(t TestingT, probe *v1core.Probe, containerName string, probeType string) {
+	if probe != nil {
+		t.Logf("Container '%v' has a %v probe.", containerName, probeType)
+	} else {
+		t.Errorf("Container '%v' does not have a %v probe.", containerName, probeType)
+	}
+}
+
+// probeHTTPGetAsExpected performs appropriate logging when comparing the HTTP GET action of a probe to its expected
+// path and port.
+func probeHTTPGetAsExpected(
+	t TestingT,
+	probe *v1core.Probe,
+	containerName string,
+	probeType string,
+	expectedPath string,
+	expectedPort intstr.IntOrString,
+) {
+	if probe == nil {
+		t.Errorf("Container '%v' does not have a %v probe.", containerName, probeType)
+		return
+	}
+
+	httpGet := probe.HTTPGet
+	if httpGet == nil {
+		t.Errorf("Container '%v' has a %v probe which does not use an HTTP GET request.", containerName, probeType)
+		return
+	}
+
+	if httpGet.Path == expectedPath && httpGet.Port == expectedPort {
+		t.Logf(
+			"Container '%v' %v probe HTTP GET is as expected.  Expected %v on port %v, got %v on port %v.",
+			containerName,
+			probeType,
+			expectedPath,
+			expectedPort.String(),
+			httpGet.Path,
+			httpGet.Port.String(),
+		)
+	} else {
+		t.Errorf(
+			"Container '%v' %v probe HTTP GET is not as expected.  Expected %v on port %v, got %v on port %v.",
+			containerName,
+			probeType,
+			expectedPath,
+			expectedPort.String(),
+			httpGet.Path,
+			httpGet.Port.String(),
+		)
+	}
+}
+
+// DeploymentConditionStatus retrieves a Deployment and checks if the status of one of its conditions is as expected.
+func DeploymentConditionStatus(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	conditionType v1.DeploymentConditionType,
+	expectedStatus v1core.ConditionStatus,
+) {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	ConditionStatusMet(t, deployment.Status.Conditions, conditionType, expectedStatus)
+}
+
+// DeploymentHasImagePullSecret determines if a Deployment's pod template references an image pull secret.
+func DeploymentHasImagePullSecret(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	secretName string,
+) {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	secretNames := localObjectReferenceNames(deployment.Spec.Template.Spec.ImagePullSecrets)
+	imagePullSecretExists(t, secretNames, "Deployment", name, secretName)
+}
+
+// ServiceAccountHasImagePullSecret determines if a ServiceAccount references an image pull secret.
+func ServiceAccountHasImagePullSecret(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	secretName string,
+) {
+	serviceAccount, err := clientset.CoreV1().ServiceAccounts(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	secretNames := localObjectReferenceNames(serviceAccount.ImagePullSecrets)
+	imagePullSecretExists(t, secretNames, "ServiceAccount", name, secretName)
+}
+
+// localObjectReferenceNames returns the names of objects referenced in a list of local object references.
+func localObjectReferenceNames(references []v1core.LocalObjectReference) []string {
+	names := make([]string, 0, len(references))
+	for _, reference := range references {
+		names = append(names, reference.Name)
+	}
+
+	return names
+}
+
+// imagePullSecretExists performs appropriate logging when checking if an object references an image pull secret.
+func imagePullSecretExists(t TestingT, secretNames []string, kind string, name string, secretName string) {
+	for _, actualName := range secretNames {
+		if actualName == secretName {
+			t.Logf("%v '%v' has the image pull secret '%v'.", kind, name, secretName)
+			return
+		}
+	}
+
+	t.Errorf(
+		"%v '%v' does not have the image pull secret '%v'.  Image pull secrets found: %v.",
+		kind,
+		name,
+		secretName,
+		secretNames,
+	)
+}
+
+// DeploymentNodeSelectorEquals determines if a Deployment's pod template has the expected node selector, which
+// restricts the nodes that its pods are scheduled on.
+func DeploymentNodeSelectorEquals(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	expected map[string]string,
+) {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	nodeSelector := deployment.Spec.Template.Spec.NodeSelector
+	if stringMapsEqual(nodeSelector, expected) {
+		t.Logf(
+			"Deployment '%v' has the expected node selector.  Expected %v, got %v.",
+			name,
+			expected,
+			nodeSelector,
+		)
+	} else {
+		t.Errorf(
+			"Deployment '%v' does not have the expected node selector.  Expected %v, got %v.  Differences: %v.",
+			name,
+			expected,
+			nodeSelector,
+			strings.Join(stringMapDifferences(nodeSelector, expected), ", "),
+		)
+	}
+}
+
+// stringMapsEqual determines if two maps contain the same keys and values.  Nil and empty maps are considered equal.
+func stringMapsEqual(first map[string]string, second map[string]string) bool {
+	if len(first) != len(second) {
+		return false
+	}
+
+	for key, value := range first {
+		if otherValue, exists := second[key]; !exists || otherValue != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeploymentHasVolumeMount determines if a container in a Deployment's pod template mounts a volume at the expected
+// path.
+func DeploymentHasVolumeMount(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	containerName string,
+	mountName string,
+	mountPath string,
+) {
+	container := getDeploymentContainer(t, clientset, name, namespace, containerName)
+
+	if container == nil {
+		return
+	}
+
+	mounts := make([]string, 0, len(container.VolumeMounts))
+	for _, volumeMount := range container.VolumeMounts {
+		if volumeMount.Name == mountName && volumeMount.MountPath == mountPath {
+			t.Logf("Container '%v' mounts volume '%v' at the expected path '%v'.", containerName, mountName, mountPath)
+			return
+		}
+
+		mounts = append(mounts, fmt.Sprintf("%v at %v", volumeMount.Name, volumeMount.MountPath))
+	}
+
+	t.Errorf(
+		"Container '%v' does not mount volume '%v' at the expected path '%v'.  Volume mounts found: %v.",
+		containerName,
+		mountName,
+		mountPath,
+		mounts,
+	)
+}
+
+// DeploymentHasToleration determines if a Deployment's pod template tolerates a taint with a given key, value, and
+// effect.  Tolerations are matched with the same rules Kubernetes uses, so an 'Exists' toleration or one with an empty
+// effect can tolerate the taint.
+func DeploymentHasToleration(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	key string,
+	value string,
+	effect v1core.TaintEffect,
+) {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	tolerations := deployment.Spec.Template.Spec.Tolerations
+	actualTolerations := make([]string, 0, len(tolerations))
+
+	taint := v1core.Taint{Key: key, Value: value, Effect: effect}
+
+	for _, toleration := range tolerations {
+		operator := toleration.Operator
+		if operator == "" {
+			operator = v1core.TolerationOpEqual
+		}
+
+		if toleration.ToleratesTaint(&taint) {
+			t.Logf("Deployment '%v' tolerates the taint %v=%v:%v.", name, key, value, effect)
+			return
+		}
+
+		actualTolerations = append(
+			actualTolerations,
+			fmt.Sprintf("%v %v %v:%v", toleration.Key, operator, toleration.Value, toleration.Effect),
+		)
+	}
+
+	t.Errorf(
+		"Deployment '%v' does not tolerate the taint %v=%v:%v.  Tolerations found: %v.",
+		name,
+		key,
+		value,
+		effect,
+		actualTolerations,
+	)
+}
+
+// DeploymentHasVolume determines if a Deployment's pod template defines a volume with a given name.  Volume mounts in
+// containers must reference volumes defined in the pod template.
+func DeploymentHasVolume(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	volumeName string,
+) {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	volumes := deployment.Spec.Template.Spec.Volumes
+	actualVolumes := make([]string, 0, len(volumes))
+
+	for _, volume := range volumes {
+		if volume.Name == volumeName {
+			t.Logf(
+				"Deployment '%v' has a volume named '%v' with a %v source.",
+				name,
+				volumeName,
+				volumeSourceKind(volume.VolumeSource),
+			)
+			return
+		}
+
+		actualVolumes = append(actualVolumes, volume.Name)
+	}
+
+	t.Errorf("Deployment '%v' does not have a volume named '%v'.  Volumes found: %v.", name, volumeName, actualVolumes)
+}
+
+// volumeSourceKind returns a readable description of the kind of source backing a volume.
+func volumeSourceKind(source v1core.VolumeSource) string {
+	switch {
+	case source.ConfigMap != nil:
+		return "ConfigMap"
+	case source.Secret != nil:
+		return "Secret"
+	case source.PersistentVolumeClaim != nil:
+		return "PersistentVolumeClaim"
+	case source.EmptyDir != nil:
+		return "EmptyDir"
+	case source.HostPath != nil:
+		return "HostPath"
+	case source.Projected != nil:
+		return "Projected"
+	default:
+		return "other"
+	}
+}
+
+// CreatedWithinDuration determines if an object was created within a certain duration of the current time.  Commonly
+// used to make sure an object was freshly created instead of left over from a previous run.
+func CreatedWithinDuration(t TestingT, creationTime v1meta.Time, d time.Duration) {
+	age := time.Since(creationTime.Time)
+
+	if age <= d {
+		t.Logf("Object was created within the expected duration.  Expected at most %v, got %v.", d, age)
+	} else {
+		t.Errorf("Object was not created within the expected duration.  Expected at most %v, got %v.", d, age)
+	}
+}
+
+// StatefulSetUpdateStrategyEquals determines if a StatefulSet uses the expected strategy to update its pods, such as
+// 'RollingUpdate' or 'OnDelete'.
+func StatefulSetUpdateStrategyEquals(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	expected v1.StatefulSetUpdateStrategyType,
+) {
+	statefulSet, err := clientset.AppsV1().StatefulSets(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	strategyType := statefulSet.Spec.UpdateStrategy.Type
+	if strategyType == expected {
+		t.Logf(
+			"StatefulSet '%v' has the expected update strategy type.  Expected %v, got %v.",
+			name,
+			expected,
+			strategyType,
+		)
+	} else {
+		t.Errorf(
+			"StatefulSet '%v' does not have the expected update strategy type.  Expected %v, got %v.",
+			name,
+			expected,
+			strategyType,
+		)
+	}
+}
+
+// StatefulSetRollingUpdatePartition determines if a StatefulSet using the 'RollingUpdate' strategy has the expected
+// partition.  Only pods with an ordinal greater than or equal to the partition are updated.  A partition that isn't
+// set defaults to 0.
+func StatefulSetRollingUpdatePartition(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	expected int32,
+) {
+	statefulSet, err := clientset.AppsV1().StatefulSets(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	updateStrategy := statefulSet.Spec.UpdateStrategy
+	if updateStrategy.Type != v1.RollingUpdateStatefulSetStrategyType {
+		t.Errorf(
+			"StatefulSet '%v' does not use the RollingUpdate strategy, so it has no partition.  Strategy type is %v.",
+			name,
+			updateStrategy.Type,
+		)
+		return
+	}
+
+	var partition int32
+	if updateStrategy.RollingUpdate != nil && updateStrategy.RollingUpdate.Partition != nil {
+		partition = *updateStrategy.RollingUpdate.Partition
+	}
+
+	if partition == expected {
+		t.Logf("StatefulSet '%v' has the expected partition.  Expected %v, got %v.", name, expected, partition)
+	} else {
+		t.Errorf("StatefulSet '%v' has an unexpected partition.  Expected %v, got %v.", name, expected, partition)
+	}
+}
+
+// DeploymentFullyReady determines if every replica requested in a Deployment's spec is ready and updated to the latest
+// pod template.  A Deployment that doesn't specify its replicas defaults to 1 replica.
+func DeploymentFullyReady(t TestingT, clientset kubernetes.Interface, name string, namespace string) {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	replicas := deploymentReplicas(deployment)
+	readyReplicas := deployment.Status.ReadyReplicas
+	updatedReplicas := deployment.Status.UpdatedReplicas
+
+	if readyReplicas == replicas && updatedReplicas == replicas {
+		t.Logf(
+			"Deployment '%v' is fully ready.  Expected %v replicas, got %v ready and %v updated.",
+			name,
+			replicas,
+			readyReplicas,
+			updatedReplicas,
+		)
+	} else {
+		t.Errorf(
+			"Deployment '%v' is not fully ready.  Expected %v replicas, got %v ready and %v updated.",
+			name,
+			replicas,
+			readyReplicas,
+			updatedReplicas,
+		)
+	}
+}
+
+// deploymentReplicas returns the number of replicas requested in a Deployment's spec, defaulting to 1 if it isn't set.
+func deploymentReplicas(deployment *v1.Deployment) int32 {
+	if deployment.Spec.Replicas == nil {
+		return 1
+	}
+
+	return *deployment.Spec.Replicas
+}
+
+// Check is a single named assertion which can be run alongside many others with RunChecks.
+type Check struct {
+	Name   string
+	Assert func(t *testing.T)
+}
+
+// RunChecks runs a list of assertions, each in its own subtest named after the check.  Failures in one check are
+// isolated from the others in the test output.
+func RunChecks(t *testing.T, checks []Check) {
+	for _, check := range checks {
+		t.Run(check.Name, check.Assert)
+	}
+}
+
+// ResourceExists determines if an object of any resource type, including custom resources, exists in a cluster.  For
+// cluster-scoped resources, pass an empty string as the namespace.
+func ResourceExists(
+	t TestingT,
+	client dynamic.Interface,
+	gvr schema.GroupVersionResource,
+	name string,
+	namespace string,
+) {
+	object, err := dynamicResource(client, gvr, namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	var now = v1meta.Now()
+	creationTimestamp := object.GetCreationTimestamp()
+
+	if namespace == "" {
+		if creationTimestamp.Before(&now) {
+			t.Logf("A '%v' object named '%v' exists.", gvr.Resource, name)
+		} else {
+			t.Errorf("A '%v' object named '%v' does not exist.", gvr.Resource, name)
+		}
+	} else {
+		if creationTimestamp.Before(&now) {
+			t.Logf("A '%v' object named '%v' exists in the '%v' namespace.", gvr.Resource, name, namespace)
+		} else {
+			t.Errorf("A '%v' object named '%v' does not exist in the '%v' namespace.", gvr.Resource, name, namespace)
+		}
+	}
+}
+
+// ServiceSelectorEquals determines if a Service selects pods using the expected labels.
+func ServiceSelectorEquals(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	expected map[string]string,
+) {
+	service, err := clientset.CoreV1().Services(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	selector := service.Spec.Selector
+	if stringMapsEqual(selector, expected) {
+		t.Logf("Service '%v' has the expected selector.  Expected %v, got %v.", name, expected, selector)
+	} else {
+		t.Errorf(
+			"Service '%v' does not have the expected selector.  Differences: %v.",
+			name,
+			strings.Join(stringMapDifferences(selector, expected), ", "),
+		)
+	}
+}
+
+// ServiceSelectsPods determines if the number of pods matching a Service's selector is as expected.  This confirms that
+// the labels on pods haven't drifted from the labels the Service selects.
+func ServiceSelectsPods(
+	t TestingT,
+	clientset kubernetes.Interface,
+	serviceName string,
+	namespace string,
+	expectedPodCount int,
+) {
+	service, err := clientset.CoreV1().Services(namespace).Get(serviceName, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	if len(service.Spec.Selector) == 0 {
+		t.Errorf("Service '%v' does not have a selector, so it does not select any pods.", serviceName)
+		return
+	}
+
+	selector := labels.SelectorFromSet(service.Spec.Selector).String()
+	pods, err := clientset.CoreV1().Pods(namespace).List(v1meta.ListOptions{LabelSelector: selector})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	var podCount = len(pods.Items)
+	if podCount == expectedPodCount {
+		t.Logf(
+			"Service '%v' selects the expected number of pods with selector '%v'.  Expected %v, got %v.",
+			serviceName,
+			selector,
+			expectedPodCount,
+			podCount,
+		)
+	} else {
+		t.Errorf(
+			"Service '%v' selects an unexpected number of pods with selector '%v'.  Expected %v, got %v.",
+			serviceName,
+			selector,
+			expectedPodCount,
+			podCount,
+		)
+	}
+}
+
+// stringMapDifferences returns a readable description of every key which is missing, unexpected, or has the wrong value
+// in an actual map when compared to an expected map.  The differences are sorted by key.
+func stringMapDifferences(actual map[string]string, expected map[string]string) []string {
+	keys := make([]string, 0, len(actual)+len(expected))
+	for key := range expected {
+		keys = append(keys, key)
+	}
+	for key := range actual {
+		if _, exists := expected[key]; !exists {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	differences := make([]string, 0)
+	for _, key := range keys {
+		actualValue, actualExists := actual[key]
+		expectedValue, expectedExists := expected[key]
+
+		if !actualExists {
+			differences = append(differences, fmt.Sprintf("%v is missing (expected %v)", key, expectedValue))
+		} else if !expectedExists {
+			differences = append(differences, fmt.Sprintf("%v is unexpected (got %v)", key, actualValue))
+		} else if actualValue != expectedValue {
+			differences = append(
+				differences,
+				fmt.Sprintf("%v has the wrong value (expected %v, got %v)", key, expectedValue, actualValue),
+			)
+		}
+	}
+
+	return differences
+}
+
+// DeploymentSelectorMatchesLabels determines if every label in a Deployment's selector exists with the same value in
+// the labels of its pod template.  Otherwise, the Deployment would not select the pods it creates.
+func DeploymentSelectorMatchesLabels(t TestingT, clientset kubernetes.Interface, name string, namespace string) {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	if deployment.Spec.Selector == nil {
+		t.Errorf("Deployment '%v' does not have a selector.", name)
+		return
+	}
+
+	templateLabels := deployment.Spec.Template.ObjectMeta.Labels
+	mismatches := make([]string, 0)
+
+	for key, value := range deployment.Spec.Selector.MatchLabels {
+		if templateValue, exists := templateLabels[key]; !exists {
+			mismatches = append(mismatches, fmt.Sprintf("%v=%v (missing from template)", key, value))
+		} else if templateValue != value {
+			mismatches = append(mismatches, fmt.Sprintf("%v=%v (template has %v)", key, value, templateValue))
+		}
+	}
+	sort.Strings(mismatches)
+
+	if len(mismatches) == 0 {
+		t.Logf("Deployment '%v' has a selector which matches its pod template labels.", name)
+	} else {
+		t.Errorf(
+			"Deployment '%v' has a selector which does not match its pod template labels.  Mismatched labels: %v.",
+			name,
+			strings.Join(mismatches, ", "),
+		)
+	}
+}
+
+// ConfigMapKeyCount determines if the number of keys in a ConfigMap, including binary data keys, is as expected.
+func ConfigMapKeyCount(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	expectedCount int,
+) {
+	configMap, err := clientset.CoreV1().ConfigMaps(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	keys := make([]string, 0, len(configMap.Data)+len(configMap.BinaryData))
+	for key := range configMap.Data {
+		keys = append(keys, key)
+	}
+	for key := range configMap.BinaryData {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var keyCount = len(keys)
+	if keyCount == expectedCount {
+		t.Logf(
+			"ConfigMap '%v' has the expected number of keys.  Expected %v, got %v.",
+			name,
+			expectedCount,
+			keyCount,
+		)
+	} else {
+		t.Errorf(
+			"ConfigMap '%v' has an unexpected number of keys.  Expected %v, got %v.  Keys found: %v.",
+			name,
+			expectedCount,
+			keyCount,
+			keys,
+		)
+	}
+}
+
+// PodContainersReady determines if every container in the pods matching a label selector is ready.  Unlike checking a
+// pod's phase, this catches containers which are crash looping in a running pod.
+func PodContainersReady(t TestingT, clientset kubernetes.Interface, namespace string, labelSelector string) {
+	pods, err := clientset.CoreV1().Pods(namespace).List(v1meta.ListOptions{LabelSelector: labelSelector})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	if len(pods.Items) == 0 {
+		t.Errorf("No pods match the label selector '%v' in the '%v' namespace.", labelSelector, namespace)
+		return
+	}
+
+	notReady := make([]string, 0)
+	containerCount := 0
+
+	for _, pod := range pods.Items {
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			containerCount++
+
+			if !containerStatus.Ready {
+				notReady = append(
+					notReady,
+					fmt.Sprintf("%v/%v (%v restarts)", pod.Name, containerStatus.Name, containerStatus.RestartCount),
+				)
+			}
+		}
+	}
+
+	if len(notReady) == 0 {
+		t.Logf(
+			"All %v containers in pods matching '%v' are ready in the '%v' namespace.",
+			containerCount,
+			labelSelector,
+			namespace,
+		)
+	} else {
+		t.Errorf(
+			"%v of %v containers in pods matching '%v' are not ready in the '%v' namespace: %v.",
+			len(notReady),
+			containerCount,
+			labelSelector,
+			namespace,
+			strings.Join(notReady, ", "),
+		)
+	}
+}
+
+// SecretKeyCount determines if the number of keys in a Secret is as expected.  Only the names of keys are logged, never
+// their values.
+func SecretKeyCount(t TestingT, clientset kubernetes.Interface, name string, namespace string, expectedCount int) {
+	secret, err := clientset.CoreV1().Secrets(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	keys := secretKeys(secret)
+
+	var keyCount = len(keys)
+	if keyCount == expectedCount {
+		t.Logf(
+			"Secret '%v' has the expected number of keys.  Expected %v, got %v.",
+			name,
+			expectedCount,
+			keyCount,
+		)
+	} else {
+		t.Errorf(
+			"Secret '%v' has an unexpected number of keys.  Expected %v, got %v.  Keys found: %v.",
+			name,
+			expectedCount,
+			keyCount,
+			keys,
+		)
+	}
+}
+
+// secretKeys returns the sorted names of the keys in a Secret's data, without exposing their values.
+func secretKeys(secret *v1core.Secret) []string {
+	keys := make([]string, 0, len(secret.Data))
+	for key := range secret.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// AssertResource retrieves an object using a getter function and runs a custom assertion against it.  The assertion
+// returns whether it passed along with a message to log.  Errors from the getter are logged as test failures.
+func AssertResource[T any](t TestingT, getFn func() (T, error), assertFn func(T) (bool, string)) {
+	object, err := getFn()
+
+	if err != nil {
+		t.Errorf("Failed to retrieve the resource to assert on: %v.", err.Error())
+		return
+	}
+
+	if passed, message := assertFn(object); passed {
+		t.Logf("%v", message)
+	} else {
+		t.Errorf("%v", message)
+	}
+}
+
+// PodRestartsBelow determines if every container in the pods matching a label selector has restarted no more than a
+// maximum number of times.  Commonly used near the end of a test to catch containers which are flapping.
+func PodRestartsBelow(
+	t TestingT,
+	clientset kubernetes.Interface,
+	namespace string,
+	labelSelector string,
+	maxRestarts int32,
+) {
+	pods, err := clientset.CoreV1().Pods(namespace).List(v1meta.ListOptions{LabelSelector: labelSelector})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	var totalRestarts int32
+	offenders := make([]string, 0)
+
+	for _, pod := range pods.Items {
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			totalRestarts += containerStatus.RestartCount
+
+			if containerStatus.RestartCount > maxRestarts {
+				offenders = append(
+					offenders,
+					fmt.Sprintf("%v/%v (%v restarts)", pod.Name, containerStatus.Name, containerStatus.RestartCount),
+				)
+			}
+		}
+	}
+
+	if len(offenders) == 0 {
+		t.Logf(
+			"No containers in pods matching '%v' restarted more than %v times.  Total restarts: %v.",
+			labelSelector,
+			maxRestarts,
+			totalRestarts,
+		)
+	} else {
+		t.Errorf(
+			"Containers in pods matching '%v' restarted more than %v times: %v.  Total restarts: %v.",
+			labelSelector,
+			maxRestarts,
+			strings.Join(offenders, ", "),
+			totalRestarts,
+		)
+	}
+}
+
+// StorageClassExists tests that a StorageClass object with a given name exists.
+func StorageClassExists(t TestingT, clientset kubernetes.Interface, name string) {
+	storageClass, err := clientset.StorageV1().StorageClasses().Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	var now = v1meta.Now()
+	if storageClass.CreationTimestamp.Before(&now) {
+		t.Logf("A StorageClass named '%v' exists.", name)
+	} else {
+		t.Errorf("A StorageClass named '%v' does not exist.", name)
+	}
+}
+
+// DeploymentGenerationObserved determines if the Deployment controller has observed the latest generation of a
+// Deployment's spec.  Until it has, the Deployment's status may not reflect recent updates.
+func DeploymentGenerationObserved(t TestingT, clientset kubernetes.Interface, name string, namespace string) {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	generation := deployment.Generation
+	observedGeneration := deployment.Status.ObservedGeneration
+
+	if observedGeneration >= generation {
+		t.Logf(
+			"Deployment '%v' has its latest generation observed.  Expected %v, got %v.",
+			name,
+			generation,
+			observedGeneration,
+		)
+	} else {
+		t.Errorf(
+			"Deployment '%v' does not have its latest generation observed.  Expected %v, got %v.",
+			name,
+			generation,
+			observedGeneration,
+		)
+	}
+}
+
+// StorageClassProvisionerEqual determines if a StorageClass uses the expected provisioner to create volumes.
+func StorageClassProvisionerEqual(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	expectedProvisioner string,
+) {
+	storageClass, err := clientset.StorageV1().StorageClasses().Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	provisioner := storageClass.Provisioner
+	if provisioner == expectedProvisioner {
+		t.Logf(
+			"StorageClass '%v' has the expected provisioner.  Expected %v, got %v.",
+			name,
+			expectedProvisioner,
+			provisioner,
+		)
+	} else {
+		t.Errorf(
+			"StorageClass '%v' does not have the expected provisioner.  Expected %v, got %v.",
+			name,
+			expectedProvisioner,
+			provisioner,
+		)
+	}
+}
+
+// PodScheduledOnNodePattern determines if a pod was scheduled on a node whose name matches an expected pattern, such as
+// the prefix of a node pool.
+func PodScheduledOnNodePattern(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	nodeNamePattern string,
+) {
+	pod, err := clientset.CoreV1().Pods(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	pattern, err := regexp.Compile(nodeNamePattern)
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	nodeName := pod.Spec.NodeName
+	if nodeName == "" {
+		t.Errorf("Pod '%v' is unscheduled.  Expected a node matching %v.", name, nodeNamePattern)
+	} else if pattern.MatchString(nodeName) {
+		t.Logf(
+			"Pod '%v' is scheduled on a node matching its expected pattern.  Expected %v, got %v.",
+			name,
+			nodeNamePattern,
+			nodeName,
+		)
+	} else {
+		t.Errorf(
+			"Pod '%v' is not scheduled on a node matching its expected pattern.  Expected %v, got %v.",
+			name,
+			nodeNamePattern,
+			nodeName,
+		)
+	}
+}
+
+// ExpectedReadyNodeCount determines if the number of nodes in a cluster with a 'Ready' condition is as expected.
+func ExpectedReadyNodeCount(t TestingT, clientset kubernetes.Interface, expectedCount int) {
+	nodes, err := clientset.CoreV1().Nodes().List(v1meta.ListOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	readyCount := 0
+	for _, node := range nodes.Items {
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == v1core.NodeReady && condition.Status == v1core.ConditionTrue {
+				readyCount++
+			}
+		}
+	}
+
+	if readyCount == expectedCount {
+		t.Logf(
+			"The expected number of ready nodes exist in the cluster.  Expected %v, got %v.",
+			expectedCount,
+			readyCount,
+		)
+	} else {
+		t.Errorf(
+			"An unexpected number of ready nodes exist in the cluster.  Expected %v, got %v.",
+			expectedCount,
+			readyCount,
+		)
+	}
+}
+
+// NodeHasLabel determines if a node has a label with an expected value, such as a label identifying its node pool.
+func NodeHasLabel(t TestingT, clientset kubernetes.Interface, name string, key string, expectedValue string) {
+	node, err := clientset.CoreV1().Nodes().Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	value, exists := node.Labels[key]
+	if exists && value == expectedValue {
+		t.Logf("Node '%v' has label %v with its expected value.  Expected %v, got %v.", name, key, expectedValue, value)
+	} else {
+		t.Errorf(
+			"Node '%v' does not have label %v with its expected value.  Expected %v, got %v.",
+			name,
+			key,
+			expectedValue,
+			value,
+		)
+	}
+}
+
+// HasOwnerReference logs a failure to a test suite if none of an object's owner references point to an owner with the
+// expected kind and name.  Otherwise, it logs a success message and the test suite will proceed with a success code.
+func HasOwnerReference(t TestingT, ownerRefs []v1meta.OwnerReference, kind string, name string) {
+	owners := make([]string, 0, len(ownerRefs))
+
+	for _, ownerRef := range ownerRefs {
+		if ownerRef.Kind == kind && ownerRef.Name == name {
+			t.Logf("Object is owned by %v '%v'.", kind, name)
+			return
+		}
+
+		owners = append(owners, fmt.Sprintf("%v/%v", ownerRef.Kind, ownerRef.Name))
+	}
+
+	t.Errorf("Object is not owned by %v '%v'.  Owners found: %v.", kind, name, owners)
+}
+
+// ContainerRunsAsNonRoot determines if a container in a Deployment's pod template must run as a non-root user.  The
+// container's security context takes precedence, falling back to the pod's security context.
+func ContainerRunsAsNonRoot(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	containerName string,
+) {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	podSpec := deployment.Spec.Template.Spec
+	container := findContainer(podSpec.Containers, containerName)
+
+	if container == nil {
+		t.Errorf("Deployment '%v' does not have a container named '%v'.", name, containerName)
+		return
+	}
+
+	var runAsNonRoot *bool
+	if container.SecurityContext != nil && container.SecurityContext.RunAsNonRoot != nil {
+		runAsNonRoot = container.SecurityContext.RunAsNonRoot
+	} else if podSpec.SecurityContext != nil {
+		runAsNonRoot = podSpec.SecurityContext.RunAsNonRoot
+	}
+
+	if runAsNonRoot != nil && *runAsNonRoot {
+		t.Logf("Container '%v' runs as a non-root user.", containerName)
+	} else {
+		t.Errorf(
+			"Container '%v' is not required to run as a non-root user.  runAsNonRoot is %v.",
+			containerName,
+			boolPointerString(runAsNonRoot),
+		)
+	}
+}
+
+// ContainerReadOnlyRootFilesystem determines if a container in a Deployment's pod template has a read-only root
+// filesystem.
+func ContainerReadOnlyRootFilesystem(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	containerName string,
+) {
+	container := getDeploymentContainer(t, clientset, name, namespace, containerName)
+
+	if container == nil {
+		return
+	}
+
+	var readOnlyRootFilesystem *bool
+	if container.SecurityContext != nil {
+		readOnlyRootFilesystem = container.SecurityContext.ReadOnlyRootFilesystem
+	}
+
+	if readOnlyRootFilesystem != nil && *readOnlyRootFilesystem {
+		t.Logf("Container '%v' has a read-only root filesystem.", containerName)
+	} else {
+		t.Errorf(
+			"Container '%v' does not have a read-only root filesystem.  readOnlyRootFilesystem is %v.",
+			containerName,
+			boolPointerString(readOnlyRootFilesystem),
+		)
+	}
+}
+
+// boolPointerString returns a readable value for an optional boolean field, which is 'unset' if the field is nil.
+func boolPointerString(value *bool) string {
+	if value == nil {
+		return "unset"
+	}
+
+	return fmt.Sprintf("%v", *value)
+}
+
+// ConfigMapIsImmutable determines if a ConfigMap is marked as immutable or not.  A ConfigMap that doesn't set the
+// field is mutable.
+func ConfigMapIsImmutable(t TestingT, client dynamic.Interface, name string, namespace string, expected bool) {
+	immutable := getImmutableField(client, "configmaps", name, namespace)
+	immutableAsExpected(t, "ConfigMap", name, expected, immutable)
+}
+
+// SecretIsImmutable determines if a Secret is marked as immutable or not.  A Secret that doesn't set the field is
+// mutable.
+func SecretIsImmutable(t TestingT, client dynamic.Interface, name string, namespace string, expected bool) {
+	immutable := getImmutableField(client, "secrets", name, namespace)
+	immutableAsExpected(t, "Secret", name, expected, immutable)
+}
+
+// getImmutableField retrieves the 'immutable' field of a ConfigMap or Secret.  The field is newer than the core API
+// types this module depends on, so it is read from the unstructured object returned by a dynamic client.
+func getImmutableField(client dynamic.Interface, resource string, name string, namespace string) *bool {
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: resource}
+	object, err := dynamicResource(client, gvr, namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	immutable, found, err := unstructured.NestedBool(object.Object, "immutable")
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	if !found {
+		return nil
+	}
+
+	return &immutable
+}
+
+// immutableAsExpected performs appropriate logging when comparing whether an object is immutable to its expected value.
+func immutableAsExpected(t TestingT, kind string, name string, expected bool, immutable *bool) {
+	actual := immutable != nil && *immutable
+
+	if actual == expected {
+		t.Logf(
+			"%v '%v' has the expected immutability.  Expected %v, got %v.",
+			kind,
+			name,
+			expected,
+			boolPointerString(immutable),
+		)
+	} else {
+		t.Errorf(
+			"%v '%v' does not have the expected immutability.  Expected %v, got %v.",
+			kind,
+			name,
+			expected,
+			boolPointerString(immutable),
+		)
+	}
+}
+
+// ExpectedPodCount determines if the number of 'Pod' objects in a namespace is as expected.
+func ExpectedPodCount(t TestingT, clientset kubernetes.Interface, namespace string, expectedCount int) {
+	pods, err := clientset.CoreV1().Pods(namespace).List(v1meta.ListOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	var actualCount = len(pods.Items)
+	if actualCount == expectedCount {
+		t.Logf(
+			"The expected number of Pods exist in the '%v' namespace.  Expected %v, got %v.",
+			namespace,
+			expectedCount,
+			actualCount,
+		)
+	} else {
+		t.Errorf(
+			"An unexpected number of Pods exist in the '%v' namespace.  Expected %v, got %v.",
+			namespace,
+			expectedCount,
+			actualCount,
+		)
+	}
+}
+
+// ServicePortCount determines if the number of ports exposed by a Service is as expected.
+func ServicePortCount(t TestingT, clientset kubernetes.Interface, name string, namespace string, expectedCount int) {
+	service, err := clientset.CoreV1().Services(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	ports := make([]string, 0, len(service.Spec.Ports))
+	for _, port := range service.Spec.Ports {
+		ports = append(ports, fmt.Sprintf("%v:%v", port.Name, port.Port))
+	}
+
+	var portCount = len(ports)
+	if portCount == expectedCount {
+		t.Logf(
+			"Service '%v' exposes the expected number of ports.  Expected %v, got %v.",
+			name,
+			expectedCount,
+			portCount,
+		)
+	} else {
+		t.Errorf(
+			"Service '%v' exposes an unexpected number of ports.  Expected %v, got %v.  Ports found: %v.",
+			name,
+			expectedCount,
+			portCount,
+			ports,
+		)
+	}
+}
+
+// ExpectedConfigMapCount determines if the number of 'ConfigMap' objects in a namespace is as expected.
+func ExpectedConfigMapCount(t TestingT, clientset kubernetes.Interface, namespace string, expectedCount int) {
+	configMaps, err := clientset.CoreV1().ConfigMaps(namespace).List(v1meta.ListOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	var actualCount = len(configMaps.Items)
+	if actualCount == expectedCount {
+		t.Logf(
+			"The expected number of ConfigMaps exist in the '%v' namespace.  Expected %v, got %v.",
+			namespace,
+			expectedCount,
+			actualCount,
+		)
+	} else {
+		t.Errorf(
+			"An unexpected number of ConfigMaps exist in the '%v' namespace.  Expected %v, got %v.",
+			namespace,
+			expectedCount,
+			actualCount,
+		)
+	}
+}
+
+// ExpectedSecretCount determines if the number of 'Secret' objects in a namespace is as expected.  Only the counts are
+// logged, never the names or data of Secrets.
+func ExpectedSecretCount(t TestingT, clientset kubernetes.Interface, namespace string, expectedCount int) {
+	secrets, err := clientset.CoreV1().Secrets(namespace).List(v1meta.ListOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	var actualCount = len(secrets.Items)
+	if actualCount == expectedCount {
+		t.Logf(
+			"The expected number of Secrets exist in the '%v' namespace.  Expected %v, got %v.",
+			namespace,
+			expectedCount,
+			actualCount,
+		)
+	} else {
+		t.Errorf(
+			"An unexpected number of Secrets exist in the '%v' namespace.  Expected %v, got %v.",
+			namespace,
+			expectedCount,
+			actualCount,
+		)
+	}
+}
+
+// DeploymentReplicasInRange determines if the number of ready replicas in a Deployment falls within an inclusive range.
+// Commonly used for Deployments whose replica count is managed by a HorizontalPodAutoscaler.
+func DeploymentReplicasInRange(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	min int32,
+	max int32,
+) {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	readyReplicas := deployment.Status.ReadyReplicas
+	if readyReplicas >= min && readyReplicas <= max {
+		t.Logf(
+			"Deployment '%v' has a number of ready replicas within the expected range.  Expected %v-%v, got %v.",
+			name,
+			min,
+			max,
+			readyReplicas,
+		)
+	} else {
+		t.Errorf(
+			"Deployment '%v' has a number of ready replicas outside the expected range.  Expected %v-%v, got %v.",
+			name,
+			min,
+			max,
+			readyReplicas,
+		)
+	}
+}
+
+// NoUnhealthyPods determines if every pod in a namespace is either running or completed.  Pods in any other phase,
+// such as 'Failed', 'Pending', or 'Unknown', are reported along with the reason they are unhealthy.
+func NoUnhealthyPods(t TestingT, clientset kubernetes.Interface, namespace string) {
+	pods, err := clientset.CoreV1().Pods(namespace).List(v1meta.ListOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	unhealthy := make([]string, 0)
+	for _, pod := range pods.Items {
+		phase := pod.Status.Phase
+
+		if phase != v1core.PodRunning && phase != v1core.PodSucceeded {
+			unhealthy = append(unhealthy, fmt.Sprintf("%v (%v: %v)", pod.Name, phase, podUnhealthyReason(pod)))
+		}
+	}
+
+	if len(unhealthy) == 0 {
+		t.Logf("All %v pods in the '%v' namespace are running or completed.", len(pods.Items), namespace)
+	} else {
+		t.Errorf(
+			"%v of %v pods in the '%v' namespace are unhealthy: %v.",
+			len(unhealthy),
+			len(pods.Items),
+			namespace,
+			strings.Join(unhealthy, ", "),
+		)
+	}
+}
+
+// podUnhealthyReason returns the most specific reason available for why a pod isn't healthy.  The pod's own reason is
+// preferred, followed by the reason a container is waiting or terminated.
+func podUnhealthyReason(pod v1core.Pod) string {
+	if pod.Status.Reason != "" {
+		return pod.Status.Reason
+	}
+
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if containerStatus.State.Waiting != nil && containerStatus.State.Waiting.Reason != "" {
+			return containerStatus.State.Waiting.Reason
+		}
+
+		if containerStatus.State.Terminated != nil && containerStatus.State.Terminated.Reason != "" {
+			return containerStatus.State.Terminated.Reason
+		}
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		if condition.Status != v1core.ConditionTrue && condition.Reason != "" {
+			return condition.Reason
+		}
+	}
+
+	return "unknown reason"
+}
+
+// DeploymentUsesServiceAccount determines if a Deployment's pods run under the expected ServiceAccount.  A pod template
+// without a ServiceAccount runs under the 'default' ServiceAccount.
+func DeploymentUsesServiceAccount(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	expectedServiceAccount string,
+) {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	serviceAccount := deployment.Spec.Template.Spec.ServiceAccountName
+	if serviceAccount == "" {
+		serviceAccount = "default"
+	}
+
+	if expectedServiceAccount == "" {
+		expectedServiceAccount = "default"
+	}
+
+	if serviceAccount == expectedServiceAccount {
+		t.Logf(
+			"Deployment '%v' uses the expected ServiceAccount.  Expected %v, got %v.",
+			name,
+			expectedServiceAccount,
+			serviceAccount,
+		)
+	} else {
+		t.Errorf(
+			"Deployment '%v' does not use the expected ServiceAccount.  Expected %v, got %v.",
+			name,
+			expectedServiceAccount,
+			serviceAccount,
+		)
+	}
+}
+
+// DeploymentAnnotationMatches retrieves a Deployment and checks if one of its annotations matches an expected pattern.
+// Commonly used for annotations managed by controllers, such as 'deployment.kubernetes.io/revision'.
+func DeploymentAnnotationMatches(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	annotationKey string,
+	pattern string,
+) {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	AnnotationsMatchPattern(t, deployment.Annotations, annotationKey, pattern)
+}
+
+// DeploymentHasChangeCause determines if a Deployment records the expected change cause.  The change cause is read from
+// the Deployment's own annotations, which are copied to its ReplicaSets and shown in its rollout history.  An
+// annotation on the pod template is used if the Deployment itself has none.
+func DeploymentHasChangeCause(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	expectedCause string,
+) {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	changeCause, exists := deployment.Annotations["kubernetes.io/change-cause"]
+	if !exists {
+		changeCause, exists = deployment.Spec.Template.Annotations["kubernetes.io/change-cause"]
+	}
+
+	if !exists {
+		t.Errorf(
+			"Deployment '%v' does not have a kubernetes.io/change-cause annotation.  Expected %v.",
+			name,
+			expectedCause,
+		)
+	} else if changeCause == expectedCause {
+		t.Logf(
+			"Deployment '%v' has the expected change cause.  Expected %v, got %v.",
+			name,
+			expectedCause,
+			changeCause,
+		)
+	} else {
+		t.Errorf(
+			"Deployment '%v' does not have the expected change cause.  Expected %v, got %v.",
+			name,
+			expectedCause,
+			changeCause,
+		)
+	}
+}
+
+// ExpectedDeploymentCountAllNamespaces determines if the number of 'Deployment' objects matching a label selector
+// across every namespace in a cluster is as expected.  Commonly used for singleton controllers.
+func ExpectedDeploymentCountAllNamespaces(
+	t TestingT,
+	clientset kubernetes.Interface,
+	labelSelector string,
+	expectedCount int,
+) {
+	deployments, err := clientset.AppsV1().Deployments("").List(v1meta.ListOptions{LabelSelector: labelSelector})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	namespaceCounts := map[string]int{}
+	for _, deployment := range deployments.Items {
+		namespaceCounts[deployment.Namespace]++
+	}
+
+	namespaces := make([]string, 0, len(namespaceCounts))
+	for namespace, count := range namespaceCounts {
+		namespaces = append(namespaces, fmt.Sprintf("%v (%v)", namespace, count))
+	}
+	sort.Strings(namespaces)
+
+	var actualCount = len(deployments.Items)
+	if actualCount == expectedCount {
+		t.Logf(
+			"The expected number of Deployments matching '%v' exist in the cluster.  Expected %v, got %v in %v.",
+			labelSelector,
+			expectedCount,
+			actualCount,
+			namespaces,
+		)
+	} else {
+		t.Errorf(
+			"An unexpected number of Deployments matching '%v' exist in the cluster.  Expected %v, got %v in %v.",
+			labelSelector,
+			expectedCount,
+			actualCount,
+			namespaces,
+		)
+	}
+}
+
+// Eventually retries an assertion until it returns no error or a timeout is reached, waiting an interval between each
+// attempt.  If the timeout is reached, the last error returned by the assertion is logged as a failure.
+func Eventually(t TestingT, timeout time.Duration, interval time.Duration, assertion func() error) {
+	deadline := time.Now().Add(timeout)
+
+	for attempt := 1; ; attempt++ {
+		err := assertion()
+
+		if err == nil {
+			t.Logf("Assertion passed after %v attempt(s).", attempt)
+			return
+		}
+
+		if time.Now().Add(interval).After(deadline) {
+			t.Errorf("Assertion did not pass within %v after %v attempt(s).  Last error: %v.", timeout, attempt, err)
+			return
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+// podLogLimitBytes is the maximum number of bytes read from a container's logs, so chatty containers don't slow tests.
+const podLogLimitBytes int64 = 1024 * 1024
+
+// PodLogContains determines if the logs of a container in a pod contain a substring.  Only the first podLogLimitBytes
+// of the logs are searched.
+func PodLogContains(
+	t TestingT,
+	clientset kubernetes.Interface,
+	namespace string,
+	podName string,
+	containerName string,
+	substring string,
+) {
+	logs, err := readPodLogs(clientset, namespace, podName, containerName)
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	if strings.Contains(string(logs), substring) {
+		t.Logf("Logs of container '%v' in pod '%v' contain '%v'.", containerName, podName, substring)
+	} else {
+		t.Errorf(
+			"Logs of container '%v' in pod '%v' do not contain '%v'.  Searched %v bytes of logs.",
+			containerName,
+			podName,
+			substring,
+			len(logs),
+		)
+	}
+}
+
+// readPodLogs retrieves the first podLogLimitBytes of the logs of a container in a pod.
+func readPodLogs(
+	clientset kubernetes.Interface,
+	namespace string,
+	podName string,
+	containerName string,
+) ([]byte, error) {
+	limitBytes := podLogLimitBytes
+	request := clientset.CoreV1().Pods(namespace).GetLogs(
+		podName,
+		&v1core.PodLogOptions{Container: containerName, LimitBytes: &limitBytes},
+	)
+
+	stream, err := request.Stream()
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer stream.Close()
+
+	return io.ReadAll(io.LimitReader(stream, limitBytes))
+}
+
+// StatefulSetVolumeClaimTemplate determines if a volume claim template in a StatefulSet requests the expected amount of
+// storage from the expected StorageClass.  Pass an empty string as the StorageClass to expect the cluster's default.
+func StatefulSetVolumeClaimTemplate(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	templateName string,
+	expectedStorage resource.Quantity,
+	expectedStorageClass string,
+) {
+	statefulSet, err := clientset.AppsV1().StatefulSets(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	var template *v1core.PersistentVolumeClaim
+	templateNames := make([]string, 0, len(statefulSet.Spec.VolumeClaimTemplates))
+
+	for i := range statefulSet.Spec.VolumeClaimTemplates {
+		if statefulSet.Spec.VolumeClaimTemplates[i].Name == templateName {
+			template = &statefulSet.Spec.VolumeClaimTemplates[i]
+		}
+
+		templateNames = append(templateNames, statefulSet.Spec.VolumeClaimTemplates[i].Name)
+	}
+
+	if template == nil {
+		t.Errorf(
+			"StatefulSet '%v' does not have a volume claim template named '%v'.  Templates found: %v.",
+			name,
+			templateName,
+			templateNames,
+		)
+		return
+	}
+
+	storage := template.Spec.Resources.Requests[v1core.ResourceStorage]
+	if QuantitiesEqual(storage, expectedStorage) {
+		t.Logf(
+			"Volume claim template '%v' requests the expected storage.  Expected %v, got %v.",
+			templateName,
+			expectedStorage.String(),
+			storage.String(),
+		)
+	} else {
+		t.Errorf(
+			"Volume claim template '%v' does not request the expected storage.  Expected %v, got %v.",
+			templateName,
+			expectedStorage.String(),
+			storage.String(),
+		)
+	}
+
+	storageClass := ""
+	if template.Spec.StorageClassName != nil {
+		storageClass = *template.Spec.StorageClassName
+	}
+
+	if storageClass == expectedStorageClass {
+		t.Logf(
+			"Volume claim template '%v' uses the expected StorageClass.  Expected %v, got %v.",
+			templateName,
+			storageClassString(expectedStorageClass),
+			storageClassString(storageClass),
+		)
+	} else {
+		t.Errorf(
+			"Volume claim template '%v' does not use the expected StorageClass.  Expected %v, got %v.",
+			templateName,
+			storageClassString(expectedStorageClass),
+			storageClassString(storageClass),
+		)
+	}
+}
+
+// storageClassString returns a readable StorageClass name, which is 'default' if no StorageClass is specified.
+func storageClassString(storageClass string) string {
+	if storageClass == "" {
+		return "default"
+	}
+
+	return storageClass
+}
+
+// DeploymentImagePullPolicyEqual determines if a container in a Deployment's pod template uses the expected image pull
+// policy, such as 'Always' or 'IfNotPresent'.
+func DeploymentImagePullPolicyEqual(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	containerName string,
+	expected v1core.PullPolicy,
+) {
+	container := getDeploymentContainer(t, clientset, name, namespace, containerName)
+
+	if container == nil {
+		return
+	}
+
+	pullPolicy := container.ImagePullPolicy
+	if pullPolicy == expected {
+		t.Logf(
+			"Container '%v' has the expected image pull policy.  Expected %v, got %v.",
+			containerName,
+			expected,
+			pullPolicy,
+		)
+	} else {
+		t.Errorf(
+			"Container '%v' does not have the expected image pull policy.  Expected %v, got %v.",
+			containerName,
+			expected,
+			pullPolicy,
+		)
+	}
+}
+
+// ObjectHasWarningEvent determines if at least one 'Warning' event was recorded for an object in a namespace.
+func ObjectHasWarningEvent(t TestingT, clientset kubernetes.Interface, namespace string, involvedObjectName string) {
+	warnings := warningEventDescriptions(listObjectEvents(clientset, namespace, involvedObjectName))
+
+	if len(warnings) > 0 {
+		t.Logf(
+			"Object '%v' has %v warning event(s): %v.",
+			involvedObjectName,
+			len(warnings),
+			strings.Join(warnings, ", "),
+		)
+	} else {
+		t.Errorf("Object '%v' does not have any warning events in the '%v' namespace.", involvedObjectName, namespace)
+	}
+}
+
+// NoWarningEvents determines if no 'Warning' events were recorded for an object in a namespace.  Warning events
+// surface problems such as failed scheduling or image pulls which aren't always reflected in an object's status.
+func NoWarningEvents(t TestingT, clientset kubernetes.Interface, namespace string, involvedObjectName string) {
+	warnings := warningEventDescriptions(listObjectEvents(clientset, namespace, involvedObjectName))
+
+	if len(warnings) == 0 {
+		t.Logf("Object '%v' does not have any warning events in the '%v' namespace.", involvedObjectName, namespace)
+	} else {
+		t.Errorf(
+			"Object '%v' has %v warning event(s): %v.",
+			involvedObjectName,
+			len(warnings),
+			strings.Join(warnings, ", "),
+		)
+	}
+}
+
+// listObjectEvents retrieves the events recorded for an object with a given name in a namespace.
+func listObjectEvents(clientset kubernetes.Interface, namespace string, involvedObjectName string) []v1core.Event {
+	fieldSelector := fields.OneTermEqualSelector("involvedObject.name", involvedObjectName).String()
+	events, err := clientset.CoreV1().Events(namespace).List(v1meta.ListOptions{FieldSelector: fieldSelector})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return events.Items
+}
+
+// warningEventDescriptions returns a readable description of each 'Warning' event in a list of events.
+func warningEventDescriptions(events []v1core.Event) []string {
+	warnings := make([]string, 0)
+	for _, event := range events {
+		if event.Type == v1core.EventTypeWarning {
+			warnings = append(warnings, fmt.Sprintf("%v (%v)", event.Reason, event.Message))
+		}
+	}
+
+	return warnings
+}
+
+// ResourceQuotaExists determines if a ResourceQuota exists in a cluster in a specific namespace.
+func ResourceQuotaExists(t TestingT, clientset kubernetes.Interface, name string, namespace string) {
+	quota, err := clientset.CoreV1().ResourceQuotas(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	var now = v1meta.Now()
+	if quota.CreationTimestamp.Before(&now) {
+		t.Logf("A ResourceQuota named '%v' exists in the '%v' namespace.", name, namespace)
+	} else {
+		t.Errorf("A ResourceQuota named '%v' does not exist in the '%v' namespace.", name, namespace)
+	}
+}
+
+// ResourceQuotaHardLimit determines if a ResourceQuota enforces the expected hard limit on a resource, such as
+// 'limits.cpu' or 'pods'.
+func ResourceQuotaHardLimit(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	resourceName v1core.ResourceName,
+	expected resource.Quantity,
+) {
+	quota, err := clientset.CoreV1().ResourceQuotas(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	hardLimit, exists := quota.Spec.Hard[resourceName]
+
+	if !exists {
+		t.Errorf(
+			"ResourceQuota '%v' does not have a hard limit on %v.  Expected %v.",
+			name,
+			resourceName,
+			expected.String(),
+		)
+	} else if QuantitiesEqual(hardLimit, expected) {
+		t.Logf(
+			"ResourceQuota '%v' has the expected hard limit on %v.  Expected %v, got %v.",
+			name,
+			resourceName,
+			expected.String(),
+			hardLimit.String(),
+		)
+	} else {
+		t.Errorf(
+			"ResourceQuota '%v' does not have the expected hard limit on %v.  Expected %v, got %v.",
+			name,
+			resourceName,
+			expected.String(),
+			hardLimit.String(),
+		)
+	}
+}
+
+// QuantitiesEqual determines if two resource quantities represent the same amount, regardless of how they are written.
+// For example, '1' and '1000m' CPU are equal, as are '1Gi' and '1024Mi' of memory.
+func QuantitiesEqual(a resource.Quantity, b resource.Quantity) bool {
+	return a.Cmp(b) == 0
+}
+
+// QuantityAtLeast determines if a resource quantity is greater than or equal to a minimum amount.
+func QuantityAtLeast(actual resource.Quantity, minimum resource.Quantity) bool {
+	return actual.Cmp(minimum) >= 0
+}
+
+// LimitRangeExists determines if a LimitRange exists in a cluster in a specific namespace.
+func LimitRangeExists(t TestingT, clientset kubernetes.Interface, name string, namespace string) {
+	limitRange, err := clientset.CoreV1().LimitRanges(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	var now = v1meta.Now()
+	if limitRange.CreationTimestamp.Before(&now) {
+		t.Logf("A LimitRange named '%v' exists in the '%v' namespace.", name, namespace)
+	} else {
+		t.Errorf("A LimitRange named '%v' does not exist in the '%v' namespace.", name, namespace)
+	}
+}
+
+// DeploymentTerminationGracePeriod determines if a Deployment's pods are given the expected number of seconds to shut
+// down gracefully.  A pod template that doesn't set the grace period defaults to 30 seconds.
+func DeploymentTerminationGracePeriod(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	expectedSeconds int64,
+) {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	var gracePeriod int64 = v1core.DefaultTerminationGracePeriodSeconds
+	if deployment.Spec.Template.Spec.TerminationGracePeriodSeconds != nil {
+		gracePeriod = *deployment.Spec.Template.Spec.TerminationGracePeriodSeconds
+	}
+
+	if gracePeriod == expectedSeconds {
+		t.Logf(
+			"Deployment '%v' has the expected termination grace period.  Expected %v, got %v.",
+			name,
+			expectedSeconds,
+			gracePeriod,
+		)
+	} else {
+		t.Errorf(
+			"Deployment '%v' does not have the expected termination grace period.  Expected %v, got %v.",
+			name,
+			expectedSeconds,
+			gracePeriod,
+		)
+	}
+}
+
+// PodDisruptionBudgetExists determines if a PodDisruptionBudget exists in a cluster in a specific namespace.  The
+// policy/v1beta1 API is used, since it is the version of the API supported by this module's Kubernetes client.
+func PodDisruptionBudgetExists(t TestingT, clientset kubernetes.Interface, name string, namespace string) {
+	pdb, err := clientset.PolicyV1beta1().PodDisruptionBudgets(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	var now = v1meta.Now()
+	if pdb.CreationTimestamp.Before(&now) {
+		t.Logf("A PodDisruptionBudget named '%v' exists in the '%v' namespace.", name, namespace)
+	} else {
+		t.Errorf("A PodDisruptionBudget named '%v' does not exist in the '%v' namespace.", name, namespace)
+	}
+}
+
+// PDBMinAvailable determines if a PodDisruptionBudget requires the expected number or percentage of pods to remain
+// available during voluntary disruptions, such as node drains.
+func PDBMinAvailable(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	expected intstr.IntOrString,
+) {
+	pdb, err := clientset.PolicyV1beta1().PodDisruptionBudgets(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	intOrStringAsExpected(t, expected, pdb.Spec.MinAvailable, "PodDisruptionBudget", name, "minAvailable")
+}
+
+// HPACurrentReplicas determines if a HorizontalPodAutoscaler currently manages the expected number of replicas.  Pair
+// it with Eventually to wait for an autoscaler to react to load.
+func HPACurrentReplicas(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	expected int32,
+) {
+	hpa, err := clientset.AutoscalingV1().HorizontalPodAutoscalers(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	hpaReplicasAsExpected(t, name, "current", expected, hpa.Status.CurrentReplicas)
+}
+
+// HPADesiredReplicas determines if a HorizontalPodAutoscaler's most recently calculated desired number of replicas is
+// as expected.
+func HPADesiredReplicas(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	expected int32,
+) {
+	hpa, err := clientset.AutoscalingV1().HorizontalPodAutoscalers(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	hpaReplicasAsExpected(t, name, "desired", expected, hpa.Status.DesiredReplicas)
+}
+
+// hpaReplicasAsExpected performs appropriate logging when comparing a replica count on a HorizontalPodAutoscaler's
+// status to its expected value.
+func hpaReplicasAsExpected(t TestingT, name string, description string, expected int32, actual int32) {
+	if actual == expected {
+		t.Logf(
+			"HorizontalPodAutoscaler '%v' has the expected number of %v replicas.  Expected %v, got %v.",
+			name,
+			description,
+			expected,
+			actual,
+		)
+	} else {
+		t.Errorf(
+			"HorizontalPodAutoscaler '%v' has an unexpected number of %v replicas.  Expected %v, got %v.",
+			name,
+			description,
+			expected,
+			actual,
+		)
+	}
+}
+
+// LabelSelectorMatches determines if every key and value in an equality-based label selector is present in a map of
+// labels.  The selector's keys are checked in sorted order, and the first key that doesn't match is reported.
+func LabelSelectorMatches(t TestingT, objectLabels map[string]string, selector map[string]string) {
+	keys := make([]string, 0, len(selector))
+	for key := range selector {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value, found := objectLabels[key]
+
+		if !found {
+			t.Errorf(
+				"Labels do not match selector '%v'.  Label '%v' is missing, expected value '%v'.",
+				labels.SelectorFromSet(selector).String(),
+				key,
+				selector[key],
+			)
+			return
+		}
+
+		if value != selector[key] {
+			t.Errorf(
+				"Labels do not match selector '%v'.  Label '%v' has an unexpected value.  Expected '%v', got '%v'.",
+				labels.SelectorFromSet(selector).String(),
+				key,
+				selector[key],
+				value,
+			)
+			return
+		}
+	}
+
+	t.Logf("Labels match selector '%v'.", labels.SelectorFromSet(selector).String())
+}
+
+// DeploymentTemplateHasLabel determines if the pod template of a Deployment has a label with an expected value.  These
+// are the labels given to the Deployment's pods, not the labels in the Deployment's own metadata, so they are the ones
+// a Service must select.
+func DeploymentTemplateHasLabel(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	key string,
+	expectedValue string,
+) {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	value, exists := deployment.Spec.Template.ObjectMeta.Labels[key]
+	if exists && value == expectedValue {
+		t.Logf(
+			"Deployment '%v' has pod template label %v with its expected value.  Expected %v, got %v.",
+			name,
+			key,
+			expectedValue,
+			value,
+		)
+	} else {
+		t.Errorf(
+			"Deployment '%v' does not have pod template label %v with its expected value.  Expected %v, got %v.",
+			name,
+			key,
+			expectedValue,
+			value,
+		)
+	}
+}
+
+// DeploymentRolloutComplete determines if a Deployment has finished rolling out, similar to 'kubectl rollout status'.
+// The Deployment controller must have observed the latest generation of the Deployment, and every requested replica
+// must be updated to the latest pod template.
+func DeploymentRolloutComplete(t TestingT, clientset kubernetes.Interface, name string, namespace string) {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	generation := deployment.Generation
+	observedGeneration := deployment.Status.ObservedGeneration
+	replicas := deploymentReplicas(deployment)
+	updatedReplicas := deployment.Status.UpdatedReplicas
+
+	if observedGeneration >= generation && updatedReplicas == replicas {
+		t.Logf(
+			"Deployment '%v' has finished rolling out.  Generation %v observed, %v of %v replicas updated.",
+			name,
+			observedGeneration,
+			updatedReplicas,
+			replicas,
+		)
+	} else {
+		t.Errorf(
+			"Deployment '%v' has not finished rolling out.  Expected generation %v observed and %v replicas "+
+				"updated, got generation %v observed and %v replicas updated.",
+			name,
+			generation,
+			replicas,
+			observedGeneration,
+			updatedReplicas,
+		)
+	}
+}
+
+// WaitForExpectedPodCount polls a cluster until the number of pods matching a label selector in a namespace is as
+// expected.  This tolerates the lag between applying a workload and all of its pods being created.
+func WaitForExpectedPodCount(
+	t TestingT,
+	clientset kubernetes.Interface,
+	namespace string,
+	labelSelector string,
+	expectedCount int,
+	retries int,
+	interval time.Duration,
+) {
+	var actualCount int
+
+	for attempt := 1; attempt <= retries; attempt++ {
+		pods, err := clientset.CoreV1().Pods(namespace).List(v1meta.ListOptions{LabelSelector: labelSelector})
+
+		if err != nil {
+			panic(err.Error())
+		}
+
+		actualCount = len(pods.Items)
+		if actualCount == expectedCount {
+			t.Logf(
+				"The expected number of Pods matching '%v' exist in the '%v' namespace after %v attempt(s).  "+
+					"Expected %v, got %v.",
+				labelSelector,
+				namespace,
+				attempt,
+				expectedCount,
+				actualCount,
+			)
+			return
+		}
+
+		t.Logf(
+			"Attempt %v of %v: %v Pods matching '%v' exist in the '%v' namespace, waiting for %v.",
+			attempt,
+			retries,
+			actualCount,
+			labelSelector,
+			namespace,
+			expectedCount,
+		)
+
+		if attempt < retries {
+			time.Sleep(interval)
+		}
+	}
+
+	t.Errorf(
+		"An unexpected number of Pods matching '%v' exist in the '%v' namespace after %v attempts.  "+
+			"Expected %v, got %v.",
+		labelSelector,
+		namespace,
+		retries,
+		expectedCount,
+		actualCount,
+	)
+}
+
+// EventsForObjectContain determines if an event with a given reason, such as 'FailedScheduling' or 'Scheduled', was
+// recorded for an object in a namespace.  On failure, the reasons of the events which were recorded are logged.
+func EventsForObjectContain(
+	t TestingT,
+	clientset kubernetes.Interface,
+	namespace string,
+	involvedObjectName string,
+	reason string,
+) {
+	events := listObjectEvents(clientset, namespace, involvedObjectName)
+
+	reasons := make([]string, 0, len(events))
+	for _, event := range events {
+		if event.Reason == reason {
+			t.Logf("Object '%v' has an event with reason '%v': %v", involvedObjectName, reason, event.Message)
+			return
+		}
+
+		reasons = append(reasons, event.Reason)
+	}
+
+	t.Errorf(
+		"Object '%v' does not have an event with reason '%v' in the '%v' namespace.  Event reasons found: %v.",
+		involvedObjectName,
+		reason,
+		namespace,
+		reasons,
+	)
+}
+
+// ContainerCommandEquals determines if a container in a Deployment's pod template overrides its image's entrypoint
+// with the expected command.
+func ContainerCommandEquals(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	containerName string,
+	expectedCommand []string,
+) {
+	container := getDeploymentContainer(t, clientset, name, namespace, containerName)
+
+	if container == nil {
+		return
+	}
+
+	stringSliceAsExpected(t, containerName, "command", expectedCommand, container.Command)
+}
+
+// ContainerArgsEquals determines if a container in a Deployment's pod template is passed the expected arguments.
+func ContainerArgsEquals(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	containerName string,
+	expectedArgs []string,
+) {
+	container := getDeploymentContainer(t, clientset, name, namespace, containerName)
+
+	if container == nil {
+		return
+	}
+
+	stringSliceAsExpected(t, containerName, "args", expectedArgs, container.Args)
+}
+
+// stringSliceAsExpected performs appropriate logging when comparing a list of strings on a container to its expected
+// value.  Nil and empty lists are considered equal, since both mean the field is unset.
+func stringSliceAsExpected(t TestingT, containerName string, description string, expected []string, actual []string) {
+	if (len(expected) == 0 && len(actual) == 0) || reflect.DeepEqual(expected, actual) {
+		t.Logf(
+			"Container '%v' has the expected %v.  Expected %q, got %q.",
+			containerName,
+			description,
+			expected,
+			actual,
+		)
+	} else {
+		t.Errorf(
+			"Container '%v' does not have the expected %v.  Expected %q, got %q.",
+			containerName,
+			description,
+			expected,
+			actual,
+		)
+	}
+}
+
+// IngressClassNameEquals determines if an Ingress is handled by the expected ingress controller, as specified by its
+// ingressClassName.  The field is newer than the networking API types this module depends on, so it is read from the
+// unstructured object returned by a dynamic client.
+func IngressClassNameEquals(
+	t TestingT,
+	client dynamic.Interface,
+	name string,
+	namespace string,
+	expectedClass string,
+) {
+	gvr := schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1beta1", Resource: "ingresses"}
+	object, err := dynamicResource(client, gvr, namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	ingressClassName, found, err := unstructured.NestedString(object.Object, "spec", "ingressClassName")
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	if !found {
+		t.Errorf(
+			"Ingress '%v' does not have an ingress class.  Expected %v, got unset.",
+			name,
+			expectedClass,
+		)
+	} else if ingressClassName == expectedClass {
+		t.Logf(
+			"Ingress '%v' has the expected ingress class.  Expected %v, got %v.",
+			name,
+			expectedClass,
+			ingressClassName,
+		)
+	} else {
+		t.Errorf(
+			"Ingress '%v' does not have the expected ingress class.  Expected %v, got %v.",
+			name,
+			expectedClass,
+			ingressClassName,
+		)
+	}
+}
+
+// ServiceExternalTrafficPolicyEqual determines if a Service routes external traffic with the expected policy.  A
+// 'Local' policy preserves the client source IP, while the default 'Cluster' policy does not.
+func ServiceExternalTrafficPolicyEqual(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	expected v1core.ServiceExternalTrafficPolicyType,
+) {
+	service, err := clientset.CoreV1().Services(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	policy := service.Spec.ExternalTrafficPolicy
+	if policy == expected {
+		t.Logf(
+			"Service '%v' has the expected external traffic policy.  Expected %v, got %v.",
+			name,
+			expected,
+			policy,
+		)
+	} else {
+		t.Errorf(
+			"Service '%v' does not have the expected external traffic policy.  Expected %v, got %v.",
+			name,
+			expected,
+			policy,
+		)
+	}
+}
+
+// MutatingWebhookConfigurationExists tests that a MutatingWebhookConfiguration object with a given name exists, which
+// confirms that an admission webhook was registered with the API server.
+func MutatingWebhookConfigurationExists(t TestingT, clientset kubernetes.Interface, name string) {
+	webhookConfiguration, err := clientset.AdmissionregistrationV1().
+		MutatingWebhookConfigurations().
+		Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	var now = v1meta.Now()
+	if webhookConfiguration.CreationTimestamp.Before(&now) {
+		t.Logf("A MutatingWebhookConfiguration named '%v' exists.", name)
+	} else {
+		t.Errorf("A MutatingWebhookConfiguration named '%v' does not exist.", name)
+	}
+}
+
+// ValidatingWebhookConfigurationExists tests that a ValidatingWebhookConfiguration object with a given name exists,
+// which confirms that an admission webhook was registered with the API server.
+func ValidatingWebhookConfigurationExists(t TestingT, clientset kubernetes.Interface, name string) {
+	webhookConfiguration, err := clientset.AdmissionregistrationV1().
+		ValidatingWebhookConfigurations().
+		Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	var now = v1meta.Now()
+	if webhookConfiguration.CreationTimestamp.Before(&now) {
+		t.Logf("A ValidatingWebhookConfiguration named '%v' exists.", name)
+	} else {
+		t.Errorf("A ValidatingWebhookConfiguration named '%v' does not exist.", name)
+	}
+}
+
+// PodSecurityContextRunAsUser determines if a pod's security context runs its containers as the expected user ID.
+func PodSecurityContextRunAsUser(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	expectedUID int64,
+) {
+	pod, err := clientset.CoreV1().Pods(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	var runAsUser *int64
+	if pod.Spec.SecurityContext != nil {
+		runAsUser = pod.Spec.SecurityContext.RunAsUser
+	}
+
+	podSecurityContextIDAsExpected(t, name, "runAsUser", expectedUID, runAsUser)
+}
+
+// PodSecurityContextFSGroup determines if a pod's security context gives ownership of its volumes to the expected
+// group ID.
+func PodSecurityContextFSGroup(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	expectedGID int64,
+) {
+	pod, err := clientset.CoreV1().Pods(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	var fsGroup *int64
+	if pod.Spec.SecurityContext != nil {
+		fsGroup = pod.Spec.SecurityContext.FSGroup
+	}
+
+	podSecurityContextIDAsExpected(t, name, "fsGroup", expectedGID, fsGroup)
+}
+
+// podSecurityContextIDAsExpected performs appropriate logging when comparing an optional user or group ID in a pod's
+// security context to its expected value.
+func podSecurityContextIDAsExpected(t TestingT, name string, description string, expected int64, actual *int64) {
+	if actual == nil {
+		t.Errorf(
+			"Pod '%v' does not have a %v in its security context.  Expected %v, got unset.",
+			name,
+			description,
+			expected,
+		)
+	} else if *actual == expected {
+		t.Logf(
+			"Pod '%v' has the expected %v in its security context.  Expected %v, got %v.",
+			name,
+			description,
+			expected,
+			*actual,
+		)
+	} else {
+		t.Errorf(
+			"Pod '%v' does not have the expected %v in its security context.  Expected %v, got %v.",
+			name,
+			description,
+			expected,
+			*actual,
+		)
+	}
+}
+
+// WebhookTargetsService determines if an admission webhook sends its requests to the expected Service.  The webhook is
+// looked up by name in a MutatingWebhookConfiguration, or in a ValidatingWebhookConfiguration if no mutating
+// configuration with the given name exists.
+func WebhookTargetsService(
+	t TestingT,
+	clientset kubernetes.Interface,
+	configName string,
+	webhookName string,
+	serviceName string,
+	serviceNamespace string,
+) {
+	webhook, exists := findWebhooks(clientset, configName)[webhookName]
+
+	if !exists {
+		t.Errorf("Webhook configuration '%v' does not have a webhook named '%v'.", configName, webhookName)
+		return
+	}
+
+	clientConfig := webhook.ClientConfig
+	service := clientConfig.Service
+	if service == nil {
+		t.Errorf(
+			"Webhook '%v' does not target a Service.  Expected %v/%v, got URL %v.",
+			webhookName,
+			serviceNamespace,
+			serviceName,
+			stringPointerString(clientConfig.URL),
+		)
+	} else if service.Name == serviceName && service.Namespace == serviceNamespace {
+		t.Logf(
+			"Webhook '%v' targets the expected Service.  Expected %v/%v, got %v/%v.",
+			webhookName,
+			serviceNamespace,
+			serviceName,
+			service.Namespace,
+			service.Name,
+		)
+	} else {
+		t.Errorf(
+			"Webhook '%v' does not target the expected Service.  Expected %v/%v, got %v/%v.",
+			webhookName,
+			serviceNamespace,
+			serviceName,
+			service.Namespace,
+			service.Name,
+		)
+	}
+}
+
+// webhookSettings holds the fields shared by mutating and validating admission webhooks which are asserted on.
+type webhookSettings struct {
+	ClientConfig  v1admissionregistration.WebhookClientConfig
+	FailurePolicy *v1admissionregistration.FailurePolicyType
+}
+
+// findWebhooks retrieves the settings of every webhook in a MutatingWebhookConfiguration, or in a
+// ValidatingWebhookConfiguration if no mutating configuration with the given name exists, keyed by webhook name.
+func findWebhooks(clientset kubernetes.Interface, configName string) map[string]webhookSettings {
+	webhooks := make(map[string]webhookSettings)
+
+	mutating, err := clientset.AdmissionregistrationV1().
+		MutatingWebhookConfigurations().
+		Get(configName, v1meta.GetOptions{})
+
+	if err == nil {
+		for _, webhook := range mutating.Webhooks {
+			webhooks[webhook.Name] = webhookSettings{webhook.ClientConfig, webhook.FailurePolicy}
+		}
+
+		return webhooks
+	}
+
+	if !k8serrors.IsNotFound(err) {
+		panic(err.Error())
+	}
+
+	validating, err := clientset.AdmissionregistrationV1().
+		ValidatingWebhookConfigurations().
+		Get(configName, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	for _, webhook := range validating.Webhooks {
+		webhooks[webhook.Name] = webhookSettings{webhook.ClientConfig, webhook.FailurePolicy}
+	}
+
+	return webhooks
+}
+
+// stringPointerString returns a readable value for an optional string field, which is 'unset' if the field is nil.
+func stringPointerString(value *string) string {
+	if value == nil {
+		return "unset"
+	}
+
+	return *value
+}
+
+// defaultJobBackoffLimit is the number of retries Kubernetes allows a Job before marking it failed if its spec doesn't
+// set a backoff limit.
+const defaultJobBackoffLimit int32 = 6
+
+// defaultJobCompletions is the number of successful pods Kubernetes requires for a Job to complete if its spec doesn't
+// set completions.
+const defaultJobCompletions int32 = 1
+
+// JobBackoffLimit determines if a Job allows the expected number of retries before it is marked failed.  A Job that
+// doesn't set its backoff limit defaults to 6 retries.
+func JobBackoffLimit(t TestingT, clientset kubernetes.Interface, name string, namespace string, expected int32) {
+	job, err := clientset.BatchV1().Jobs(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	backoffLimit := defaultJobBackoffLimit
+	if job.Spec.BackoffLimit != nil {
+		backoffLimit = *job.Spec.BackoffLimit
+	}
+
+	jobSpecValueAsExpected(t, name, "backoff limit", expected, backoffLimit)
+}
+
+// JobCompletions determines if a Job requires the expected number of pods to succeed before it is complete.  A Job
+// that doesn't set its completions defaults to 1.
+func JobCompletions(t TestingT, clientset kubernetes.Interface, name string, namespace string, expected int32) {
+	job, err := clientset.BatchV1().Jobs(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	completions := defaultJobCompletions
+	if job.Spec.Completions != nil {
+		completions = *job.Spec.Completions
+	}
+
+	jobSpecValueAsExpected(t, name, "completions", expected, completions)
+}
+
+// jobSpecValueAsExpected performs appropriate logging when comparing a numeric value in a Job's spec to its expected
+// value.
+func jobSpecValueAsExpected(t TestingT, name string, description string, expected int32, actual int32) {
+	if actual == expected {
+		t.Logf("Job '%v' has the expected %v.  Expected %v, got %v.", name, description, expected, actual)
+	} else {
+		t.Errorf("Job '%v' does not have the expected %v.  Expected %v, got %v.", name, description, expected, actual)
+	}
+}
+
+// CRDEstablished determines if a CustomResourceDefinition has been accepted by the API server, meaning that custom
+// resources of its kind can be created.  The CRD's 'Established' condition must be 'True'.
+func CRDEstablished(t TestingT, apiextClient apiextensionsclientset.Interface, name string) {
+	crd, err := apiextClient.ApiextensionsV1().CustomResourceDefinitions().Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	for _, condition := range crd.Status.Conditions {
+		if condition.Type != v1apiextensions.Established {
+			continue
+		}
+
+		if condition.Status == v1apiextensions.ConditionTrue {
+			t.Logf("CustomResourceDefinition '%v' is established.", name)
+		} else {
+			t.Errorf(
+				"CustomResourceDefinition '%v' is not established.  Status %v, reason '%v', message '%v'.",
+				name,
+				condition.Status,
+				condition.Reason,
+				condition.Message,
+			)
+		}
+
+		return
+	}
+
+	t.Errorf("CustomResourceDefinition '%v' does not have an 'Established' condition.", name)
+}
+
+// defaultCronJobSuccessfulJobsHistoryLimit is the number of successful Jobs Kubernetes keeps for a CronJob if its spec
+// doesn't set a limit.
+const defaultCronJobSuccessfulJobsHistoryLimit int32 = 3
+
+// defaultCronJobFailedJobsHistoryLimit is the number of failed Jobs Kubernetes keeps for a CronJob if its spec doesn't
+// set a limit.
+const defaultCronJobFailedJobsHistoryLimit int32 = 1
+
+// CronJobConcurrencyPolicy determines if a CronJob handles overlapping runs with the expected concurrency policy, such
+// as 'Forbid' to skip a run while the previous one is still active.  The batch/v1beta1 API is used, since it is the
+// version of the API supported by this module's Kubernetes client.
+func CronJobConcurrencyPolicy(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	expected v1beta1batch.ConcurrencyPolicy,
+) {
+	cronJob, err := clientset.BatchV1beta1().CronJobs(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	concurrencyPolicy := cronJob.Spec.ConcurrencyPolicy
+	if concurrencyPolicy == expected {
+		t.Logf(
+			"CronJob '%v' has the expected concurrency policy.  Expected %v, got %v.",
+			name,
+			expected,
+			concurrencyPolicy,
+		)
+	} else {
+		t.Errorf(
+			"CronJob '%v' does not have the expected concurrency policy.  Expected %v, got %v.",
+			name,
+			expected,
+			concurrencyPolicy,
+		)
+	}
+}
+
+// CronJobSuccessfulJobsHistoryLimit determines if a CronJob keeps the expected number of successful Jobs.  A CronJob
+// that doesn't set the limit defaults to 3.
+func CronJobSuccessfulJobsHistoryLimit(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	expected int32,
+) {
+	cronJob, err := clientset.BatchV1beta1().CronJobs(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	historyLimit := defaultCronJobSuccessfulJobsHistoryLimit
+	if cronJob.Spec.SuccessfulJobsHistoryLimit != nil {
+		historyLimit = *cronJob.Spec.SuccessfulJobsHistoryLimit
+	}
+
+	cronJobHistoryLimitAsExpected(t, name, "successful", expected, historyLimit)
+}
+
+// CronJobFailedJobsHistoryLimit determines if a CronJob keeps the expected number of failed Jobs.  A CronJob that
+// doesn't set the limit defaults to 1.
+func CronJobFailedJobsHistoryLimit(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	expected int32,
+) {
+	cronJob, err := clientset.BatchV1beta1().CronJobs(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	historyLimit := defaultCronJobFailedJobsHistoryLimit
+	if cronJob.Spec.FailedJobsHistoryLimit != nil {
+		historyLimit = *cronJob.Spec.FailedJobsHistoryLimit
+	}
+
+	cronJobHistoryLimitAsExpected(t, name, "failed", expected, historyLimit)
+}
+
+// cronJobHistoryLimitAsExpected performs appropriate logging when comparing a CronJob's job history limit to its
+// expected value.
+func cronJobHistoryLimitAsExpected(t TestingT, name string, description string, expected int32, actual int32) {
+	if actual == expected {
+		t.Logf(
+			"CronJob '%v' keeps the expected number of %v Jobs.  Expected %v, got %v.",
+			name,
+			description,
+			expected,
+			actual,
+		)
+	} else {
+		t.Errorf(
+			"CronJob '%v' keeps an unexpected number of %v Jobs.  Expected %v, got %v.",
+			name,
+			description,
+			expected,
+			actual,
+		)
+	}
+}
+
+// ResourceFieldEquals determines if a field of an object of any resource type, including custom resources, has the
+// expected value.  The field is given as a dotted path such as 'spec.replicas'.  Numbers in the object are int64 or
+// float64 values, so expected numbers must use the same types.  For cluster-scoped resources, pass an empty string as
+// the namespace.
+func ResourceFieldEquals(
+	t TestingT,
+	client dynamic.Interface,
+	gvr schema.GroupVersionResource,
+	name string,
+	namespace string,
+	fieldPath string,
+	expected interface{},
+) {
+	object, err := dynamicResource(client, gvr, namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	value, found, err := unstructured.NestedFieldNoCopy(object.Object, strings.Split(fieldPath, ".")...)
+
+	if err != nil {
+		t.Errorf("Field '%v' of '%v' object '%v' could not be read: %v.", fieldPath, gvr.Resource, name, err)
+		return
+	}
+
+	if !found {
+		t.Errorf(
+			"Field '%v' of '%v' object '%v' is not set.  Expected %v.",
+			fieldPath,
+			gvr.Resource,
+			name,
+			expected,
+		)
+	} else if reflect.DeepEqual(value, expected) {
+		t.Logf(
+			"Field '%v' of '%v' object '%v' has the expected value.  Expected %v, got %v.",
+			fieldPath,
+			gvr.Resource,
+			name,
+			expected,
+			value,
+		)
+	} else {
+		t.Errorf(
+			"Field '%v' of '%v' object '%v' has an unexpected value.  Expected %v (%T), got %v (%T).",
+			fieldPath,
+			gvr.Resource,
+			name,
+			expected,
+			expected,
+			value,
+			value,
+		)
+	}
+}
+
+// dynamicResource returns a dynamic client for a resource type, scoped to a namespace unless the namespace is empty.
+func dynamicResource(
+	client dynamic.Interface,
+	gvr schema.GroupVersionResource,
+	namespace string,
+) dynamic.ResourceInterface {
+	if namespace == "" {
+		return client.Resource(gvr)
+	}
+
+	return client.Resource(gvr).Namespace(namespace)
+}
+
+// PodsOnNodesWithLabel determines if every pod matching a label selector is scheduled on a node with an expected label,
+// such as a label identifying a dedicated node pool.  Pods which are unscheduled or on other nodes are reported.
+func PodsOnNodesWithLabel(
+	t TestingT,
+	clientset kubernetes.Interface,
+	namespace string,
+	podLabelSelector string,
+	nodeLabelKey string,
+	nodeLabelValue string,
+) {
+	pods, err := clientset.CoreV1().Pods(namespace).List(v1meta.ListOptions{LabelSelector: podLabelSelector})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	if len(pods.Items) == 0 {
+		t.Errorf("No Pods matching '%v' exist in the '%v' namespace.", podLabelSelector, namespace)
+		return
+	}
+
+	nodeLabelMatches := make(map[string]bool)
+	misplacedPods := make([]string, 0)
+
+	for _, pod := range pods.Items {
+		nodeName := pod.Spec.NodeName
+		if nodeName == "" {
+			misplacedPods = append(misplacedPods, fmt.Sprintf("%v (unscheduled)", pod.Name))
+			continue
+		}
+
+		matches, checked := nodeLabelMatches[nodeName]
+		if !checked {
+			node, err := clientset.CoreV1().Nodes().Get(nodeName, v1meta.GetOptions{})
+
+			if err != nil {
+				panic(err.Error())
+			}
+
+			value, exists := node.Labels[nodeLabelKey]
+			matches = exists && value == nodeLabelValue
+			nodeLabelMatches[nodeName] = matches
+		}
+
+		if !matches {
+			misplacedPods = append(misplacedPods, fmt.Sprintf("%v (on node %v)", pod.Name, nodeName))
+		}
+	}
+
+	if len(misplacedPods) == 0 {
+		t.Logf(
+			"All %v Pods matching '%v' are scheduled on nodes with label %v=%v.",
+			len(pods.Items),
+			podLabelSelector,
+			nodeLabelKey,
+			nodeLabelValue,
+		)
+	} else {
+		t.Errorf(
+			"%v of %v Pods matching '%v' are not scheduled on nodes with label %v=%v: %v.",
+			len(misplacedPods),
+			len(pods.Items),
+			podLabelSelector,
+			nodeLabelKey,
+			nodeLabelValue,
+			strings.Join(misplacedPods, ", "),
+		)
+	}
+}
+
+// StorageClassReclaimPolicyEqual determines if volumes created by a StorageClass have the expected reclaim policy, such
+// as 'Retain' to keep a volume after its claim is deleted.  A StorageClass that doesn't set the policy defaults to
+// 'Delete'.
+func StorageClassReclaimPolicyEqual(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	expected v1core.PersistentVolumeReclaimPolicy,
+) {
+	storageClass, err := clientset.StorageV1().StorageClasses().Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	reclaimPolicy := v1core.PersistentVolumeReclaimDelete
+	if storageClass.ReclaimPolicy != nil {
+		reclaimPolicy = *storageClass.ReclaimPolicy
+	}
+
+	if reclaimPolicy == expected {
+		t.Logf(
+			"StorageClass '%v' has the expected reclaim policy.  Expected %v, got %v.",
+			name,
+			expected,
+			reclaimPolicy,
+		)
+	} else {
+		t.Errorf(
+			"StorageClass '%v' does not have the expected reclaim policy.  Expected %v, got %v.",
+			name,
+			expected,
+			reclaimPolicy,
+		)
+	}
+}
+
+// StorageClassVolumeBindingModeEqual determines if a StorageClass binds and provisions volumes with the expected mode,
+// such as 'WaitForFirstConsumer' to delay provisioning until a pod is scheduled.  A StorageClass that doesn't set the
+// mode defaults to 'Immediate'.
+func StorageClassVolumeBindingModeEqual(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	expected v1storage.VolumeBindingMode,
+) {
+	storageClass, err := clientset.StorageV1().StorageClasses().Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	volumeBindingMode := v1storage.VolumeBindingImmediate
+	if storageClass.VolumeBindingMode != nil {
+		volumeBindingMode = *storageClass.VolumeBindingMode
+	}
+
+	if volumeBindingMode == expected {
+		t.Logf(
+			"StorageClass '%v' has the expected volume binding mode.  Expected %v, got %v.",
+			name,
+			expected,
+			volumeBindingMode,
+		)
+	} else {
+		t.Errorf(
+			"StorageClass '%v' does not have the expected volume binding mode.  Expected %v, got %v.",
+			name,
+			expected,
+			volumeBindingMode,
+		)
+	}
+}
+
+// DeploymentAvailabilityAtLeast determines if at least a minimum percentage of a Deployment's requested replicas are
+// available.  A Deployment scaled to zero replicas is considered fully available.
+func DeploymentAvailabilityAtLeast(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	minPercent float64,
+) {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	replicas := deploymentReplicas(deployment)
+	availableReplicas := deployment.Status.AvailableReplicas
+
+	availablePercent := 100.0
+	if replicas > 0 {
+		availablePercent = float64(availableReplicas) / float64(replicas) * 100
+	}
+
+	if availablePercent >= minPercent {
+		t.Logf(
+			"Deployment '%v' has enough available replicas.  Expected at least %.1f%%, got %.1f%% (%v of %v).",
+			name,
+			minPercent,
+			availablePercent,
+			availableReplicas,
+			replicas,
+		)
+	} else {
+		t.Errorf(
+			"Deployment '%v' does not have enough available replicas.  "+
+				"Expected at least %.1f%%, got %.1f%% (%v of %v).",
+			name,
+			minPercent,
+			availablePercent,
+			availableReplicas,
+			replicas,
+		)
+	}
+}
+
+// PersistentVolumeBoundToClaim determines if a PersistentVolume is bound to the expected PersistentVolumeClaim.  The
+// volume must be in the 'Bound' phase and its claim reference must point to the claim.
+func PersistentVolumeBoundToClaim(
+	t TestingT,
+	clientset kubernetes.Interface,
+	pvName string,
+	expectedClaimNamespace string,
+	expectedClaimName string,
+) {
+	pv, err := clientset.CoreV1().PersistentVolumes().Get(pvName, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	phase := pv.Status.Phase
+	claimRef := pv.Spec.ClaimRef
+
+	actualClaim := "unset"
+	if claimRef != nil {
+		actualClaim = fmt.Sprintf("%v/%v", claimRef.Namespace, claimRef.Name)
+	}
+
+	if phase == v1core.VolumeBound &&
+		claimRef != nil &&
+		claimRef.Namespace == expectedClaimNamespace &&
+		claimRef.Name == expectedClaimName {
+		t.Logf(
+			"PersistentVolume '%v' is bound to the expected claim.  Expected %v/%v, got %v.",
+			pvName,
+			expectedClaimNamespace,
+			expectedClaimName,
+			actualClaim,
+		)
+	} else {
+		t.Errorf(
+			"PersistentVolume '%v' is not bound to the expected claim.  Expected %v/%v, got %v in phase %v.",
+			pvName,
+			expectedClaimNamespace,
+			expectedClaimName,
+			actualClaim,
+			phase,
+		)
+	}
+}
+
+// GetDeployment retrieves a Deployment without asserting anything about it.  Along with the other getters, it can be
+// used with AssertResource or in custom checks which aren't covered by this module.
+func GetDeployment(clientset kubernetes.Interface, name string, namespace string) (*v1.Deployment, error) {
+	return clientset.AppsV1().Deployments(namespace).Get(name, v1meta.GetOptions{})
+}
+
+// GetStatefulSet retrieves a StatefulSet without asserting anything about it.
+func GetStatefulSet(clientset kubernetes.Interface, name string, namespace string) (*v1.StatefulSet, error) {
+	return clientset.AppsV1().StatefulSets(namespace).Get(name, v1meta.GetOptions{})
+}
+
+// GetService retrieves a Service without asserting anything about it.
+func GetService(clientset kubernetes.Interface, name string, namespace string) (*v1core.Service, error) {
+	return clientset.CoreV1().Services(namespace).Get(name, v1meta.GetOptions{})
+}
+
+// GetPod retrieves a Pod without asserting anything about it.
+func GetPod(clientset kubernetes.Interface, name string, namespace string) (*v1core.Pod, error) {
+	return clientset.CoreV1().Pods(namespace).Get(name, v1meta.GetOptions{})
+}
+
+// GetConfigMap retrieves a ConfigMap without asserting anything about it.
+func GetConfigMap(clientset kubernetes.Interface, name string, namespace string) (*v1core.ConfigMap, error) {
+	return clientset.CoreV1().ConfigMaps(namespace).Get(name, v1meta.GetOptions{})
+}
+
+// GetSecret retrieves a Secret without asserting anything about it.
+func GetSecret(clientset kubernetes.Interface, name string, namespace string) (*v1core.Secret, error) {
+	return clientset.CoreV1().Secrets(namespace).Get(name, v1meta.GetOptions{})
+}
+
+// EndpointSliceReadyCount determines if the number of ready endpoints across all the EndpointSlices of a Service is as
+// expected.  Endpoints with an unknown ready condition are counted as ready.  The discovery/v1beta1 API is used,
+// since it is enabled by default on clusters, unlike discovery/v1alpha1.
+func EndpointSliceReadyCount(
+	t TestingT,
+	clientset kubernetes.Interface,
+	serviceName string,
+	namespace string,
+	expectedReady int,
+) {
+	selector := labels.SelectorFromSet(map[string]string{v1beta1discovery.LabelServiceName: serviceName}).String()
+	endpointSlices, err := clientset.DiscoveryV1beta1().
+		EndpointSlices(namespace).
+		List(v1meta.ListOptions{LabelSelector: selector})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	sliceNames := make([]string, 0, len(endpointSlices.Items))
+	readyCount := 0
+
+	for _, endpointSlice := range endpointSlices.Items {
+		sliceNames = append(sliceNames, endpointSlice.Name)
+
+		for _, endpoint := range endpointSlice.Endpoints {
+			if endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready {
+				readyCount++
+			}
+		}
+	}
+
+	if readyCount == expectedReady {
+		t.Logf(
+			"Service '%v' has the expected number of ready endpoints in EndpointSlices %v.  Expected %v, got %v.",
+			serviceName,
+			sliceNames,
+			expectedReady,
+			readyCount,
+		)
+	} else {
+		t.Errorf(
+			"Service '%v' has an unexpected number of ready endpoints in EndpointSlices %v.  Expected %v, got %v.",
+			serviceName,
+			sliceNames,
+			expectedReady,
+			readyCount,
+		)
+	}
+}
+
+// ResourceQuotaUsageWithinLimit determines if a ResourceQuota is being enforced on a resource and its usage hasn't
+// exceeded the hard limit.  The quota controller must have recorded both the hard limit and the usage in the quota's
+// status, which only happens once the quota is active.
+func ResourceQuotaUsageWithinLimit(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	resourceName v1core.ResourceName,
+) {
+	quota, err := clientset.CoreV1().ResourceQuotas(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	hardLimit, hardExists := quota.Status.Hard[resourceName]
+	used, usedExists := quota.Status.Used[resourceName]
+
+	if !hardExists || !usedExists {
+		t.Errorf("ResourceQuota '%v' is not tracking usage of %v in its status.", name, resourceName)
+	} else if QuantityAtLeast(hardLimit, used) {
+		t.Logf(
+			"ResourceQuota '%v' has usage of %v within its hard limit.  Limit %v, used %v.",
+			name,
+			resourceName,
+			hardLimit.String(),
+			used.String(),
+		)
+	} else {
+		t.Errorf(
+			"ResourceQuota '%v' has usage of %v exceeding its hard limit.  Limit %v, used %v.",
+			name,
+			resourceName,
+			hardLimit.String(),
+			used.String(),
+		)
+	}
+}
+
+// SecretHasKeys determines if a Secret's data contains every expected key, such as 'tls.crt' and 'tls.key' in a TLS
+// Secret.  Only the names of keys are logged, never their values.
+func SecretHasKeys(t TestingT, clientset kubernetes.Interface, name string, namespace string, keys ...string) {
+	secret, err := clientset.CoreV1().Secrets(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	missingKeys := make([]string, 0)
+	for _, key := range keys {
+		if _, exists := secret.Data[key]; !exists {
+			missingKeys = append(missingKeys, key)
+		}
+	}
+
+	if len(missingKeys) == 0 {
+		t.Logf("Secret '%v' has all of its expected keys: %v.", name, keys)
+	} else {
+		t.Errorf(
+			"Secret '%v' is missing %v of its %v expected keys: %v.  Keys found: %v.",
+			name,
+			len(missingKeys),
+			len(keys),
+			missingKeys,
+			secretKeys(secret),
+		)
+	}
+}
+
+// PriorityClassExists tests that a PriorityClass object with a given name exists.
+func PriorityClassExists(t TestingT, clientset kubernetes.Interface, name string) {
+	priorityClass, err := clientset.SchedulingV1().PriorityClasses().Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	var now = v1meta.Now()
+	if priorityClass.CreationTimestamp.Before(&now) {
+		t.Logf("A PriorityClass named '%v' exists.", name)
+	} else {
+		t.Errorf("A PriorityClass named '%v' does not exist.", name)
+	}
+}
+
+// PriorityClassValueEqual determines if a PriorityClass has the expected priority value.  Pods with a higher value
+// are scheduled first and can preempt pods with a lower value.
+func PriorityClassValueEqual(t TestingT, clientset kubernetes.Interface, name string, expected int32) {
+	priorityClass, err := clientset.SchedulingV1().PriorityClasses().Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	value := priorityClass.Value
+	if value == expected {
+		t.Logf("PriorityClass '%v' has the expected value.  Expected %v, got %v.", name, expected, value)
+	} else {
+		t.Errorf("PriorityClass '%v' does not have the expected value.  Expected %v, got %v.", name, expected, value)
+	}
+}
+
+// LimitRangeHasDefault determines if a LimitRange gives containers the expected default limit on a resource, such as
+// 'cpu' or 'memory'.  This is the limit applied to containers which don't specify their own.
+func LimitRangeHasDefault(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	resourceName v1core.ResourceName,
+	expected resource.Quantity,
+) {
+	limitRangeDefaultAsExpected(t, clientset, name, namespace, resourceName, expected, false)
+}
+
+// LimitRangeHasDefaultRequest determines if a LimitRange gives containers the expected default request on a resource,
+// such as 'cpu' or 'memory'.  This is the request applied to containers which don't specify their own.
+func LimitRangeHasDefaultRequest(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	resourceName v1core.ResourceName,
+	expected resource.Quantity,
+) {
+	limitRangeDefaultAsExpected(t, clientset, name, namespace, resourceName, expected, true)
+}
+
+// limitRangeDefaultAsExpected performs appropriate logging when comparing a LimitRange's default limit or default
+// request on a resource to its expected value.  The limit types found in the LimitRange are logged on failure.
+func limitRangeDefaultAsExpected(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	resourceName v1core.ResourceName,
+	expected resource.Quantity,
+	request bool,
+) {
+	limitRange, err := clientset.CoreV1().LimitRanges(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	description := "default limit"
+	if request {
+		description = "default request"
+	}
+
+	limitTypes := make([]string, 0, len(limitRange.Spec.Limits))
+	for _, limit := range limitRange.Spec.Limits {
+		limitTypes = append(limitTypes, string(limit.Type))
+
+		defaults := limit.Default
+		if request {
+			defaults = limit.DefaultRequest
+		}
+
+		value, exists := defaults[resourceName]
+		if !exists {
+			continue
+		}
+
+		if QuantitiesEqual(value, expected) {
+			t.Logf(
+				"LimitRange '%v' has the expected %v on %v for type %v.  Expected %v, got %v.",
+				name,
+				description,
+				resourceName,
+				limit.Type,
+				expected.String(),
+				value.String(),
+			)
+		} else {
+			t.Errorf(
+				"LimitRange '%v' does not have the expected %v on %v for type %v.  Expected %v, got %v.",
+				name,
+				description,
+				resourceName,
+				limit.Type,
+				expected.String(),
+				value.String(),
+			)
+		}
+
+		return
+	}
+
+	t.Errorf(
+		"LimitRange '%v' does not have a %v on %v.  Expected %v.  Limit types found: %v.",
+		name,
+		description,
+		resourceName,
+		expected.String(),
+		limitTypes,
+	)
+}
+
+// DeploymentHasPodAntiAffinity determines if a Deployment's pod template has a required or preferred pod anti-affinity
+// term with the expected topology key, such as 'kubernetes.io/hostname' to spread replicas across nodes.  The topology
+// keys of the configured anti-affinity terms are logged on failure.
+func DeploymentHasPodAntiAffinity(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	topologyKey string,
+) {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	affinity := deployment.Spec.Template.Spec.Affinity
+	if affinity == nil || affinity.PodAntiAffinity == nil {
+		t.Errorf("Deployment '%v' does not have pod anti-affinity.  Expected topology key %v.", name, topologyKey)
+		return
+	}
+
+	antiAffinity := affinity.PodAntiAffinity
+	configuredTerms := make([]string, 0)
+
+	for _, term := range antiAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+		if term.TopologyKey == topologyKey {
+			t.Logf("Deployment '%v' has required pod anti-affinity on topology key %v.", name, topologyKey)
+			return
+		}
+
+		configuredTerms = append(configuredTerms, fmt.Sprintf("required on %v", term.TopologyKey))
+	}
+
+	for _, weightedTerm := range antiAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+		if weightedTerm.PodAffinityTerm.TopologyKey == topologyKey {
+			t.Logf(
+				"Deployment '%v' has preferred pod anti-affinity on topology key %v with weight %v.",
+				name,
+				topologyKey,
+				weightedTerm.Weight,
+			)
+			return
+		}
+
+		configuredTerms = append(
+			configuredTerms,
+			fmt.Sprintf("preferred on %v", weightedTerm.PodAffinityTerm.TopologyKey),
+		)
+	}
+
+	t.Errorf(
+		"Deployment '%v' does not have pod anti-affinity on topology key %v.  Anti-affinity terms found: %v.",
+		name,
+		topologyKey,
+		configuredTerms,
+	)
+}
+
+// WaitForJobCompletion polls a cluster until a Job has completed.  If the Job fails or doesn't complete within the
+// given number of retries, a failure is logged along with the logs of the Job's failed pods.
+func WaitForJobCompletion(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	retries int,
+	interval time.Duration,
+) {
+	var job *v1batch.Job
+
+	for attempt := 1; attempt <= retries; attempt++ {
+		var err error
+		job, err = clientset.BatchV1().Jobs(namespace).Get(name, v1meta.GetOptions{})
+
+		if err != nil {
+			panic(err.Error())
+		}
+
+		for _, condition := range job.Status.Conditions {
+			if condition.Status != v1core.ConditionTrue {
+				continue
+			}
+
+			if condition.Type == v1batch.JobComplete {
+				t.Logf("Job '%v' completed after %v attempt(s).", name, attempt)
+				return
+			}
+
+			if condition.Type == v1batch.JobFailed {
+				t.Errorf(
+					"Job '%v' failed after %v attempt(s).  Reason '%v', message '%v'.",
+					name,
+					attempt,
+					condition.Reason,
+					condition.Message,
+				)
+				logFailedJobPods(t, clientset, job)
+				return
+			}
+		}
+
+		if attempt < retries {
+			time.Sleep(interval)
+		}
+	}
+
+	if job == nil {
+		t.Errorf("Job '%v' was not confirmed complete.  No attempts were made.", name)
+		return
+	}
+
+	t.Errorf(
+		"Job '%v' did not complete after %v attempts.  Active %v, succeeded %v, failed %v.",
+		name,
+		retries,
+		job.Status.Active,
+		job.Status.Succeeded,
+		job.Status.Failed,
+	)
+	logFailedJobPods(t, clientset, job)
+}
+
+// logFailedJobPods logs the logs of every container in a Job's failed pods, to help debug why the Job didn't complete.
+// Errors retrieving logs are logged instead of causing a panic, since the Job's failure is already being reported.
+func logFailedJobPods(t TestingT, clientset kubernetes.Interface, job *v1batch.Job) {
+	selector, err := v1meta.LabelSelectorAsSelector(job.Spec.Selector)
+
+	if err != nil {
+		t.Logf("Unable to find the pods of Job '%v': %v.", job.Name, err)
+		return
+	}
+
+	pods, err := clientset.CoreV1().Pods(job.Namespace).List(v1meta.ListOptions{LabelSelector: selector.String()})
+
+	if err != nil {
+		t.Logf("Unable to list the pods of Job '%v': %v.", job.Name, err)
+		return
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != v1core.PodFailed {
+			continue
+		}
+
+		for _, container := range pod.Spec.Containers {
+			logs, err := readPodLogs(clientset, pod.Namespace, pod.Name, container.Name)
+
+			if err != nil {
+				t.Logf("Unable to read the logs of container '%v' in pod '%v': %v.", container.Name, pod.Name, err)
+			} else {
+				t.Logf("Logs of container '%v' in failed pod '%v':\n%v", container.Name, pod.Name, string(logs))
+			}
+		}
+	}
+}
+
+// PollUntil repeatedly fetches an object until a condition holds, waiting an interval between each attempt, and returns
+// the object which satisfied the condition.  Errors from the fetch function are retried.  If the condition doesn't hold
+// within the given number of retries, the test is stopped with Fatalf, since the returned object can't be trusted by
+// later assertions.
+func PollUntil[T any](
+	t TestingT,
+	retries int,
+	interval time.Duration,
+	fetch func() (T, error),
+	condition func(T) bool,
+) T {
+	var object T
+	var lastErr error
+
+	for attempt := 1; attempt <= retries; attempt++ {
+		var err error
+		object, err = fetch()
+
+		if err == nil && condition(object) {
+			t.Logf("Condition was met after %v attempt(s).", attempt)
+			return object
+		}
+
+		lastErr = err
+
+		if attempt < retries {
+			time.Sleep(interval)
+		}
+	}
+
+	if lastErr != nil {
+		t.Fatalf("Condition was not met after %v attempts.  Last error: %v.", retries, lastErr)
+	} else {
+		t.Fatalf("Condition was not met after %v attempts.", retries)
+	}
+
+	return object
+}
+
+// allExistConcurrency is the maximum number of requests AllExist makes to the Kubernetes API at the same time.
+const allExistConcurrency = 8
+
+// ExistenceCheck identifies an object which AllExist confirms exists.  Kind is a resource kind such as 'Deployment'
+// or 'ConfigMap'.  For cluster-scoped kinds, Namespace is ignored.
+type ExistenceCheck struct {
+	Kind      string
+	Name      string
+	Namespace string
+}
+
+// AllExist determines if every object in a list of existence checks exists in a cluster.  The objects are retrieved
+// concurrently, but results are logged in the order of the checks so test output is deterministic.
+func AllExist(t TestingT, clientset kubernetes.Interface, checks []ExistenceCheck) {
+	results := make([]error, len(checks))
+	semaphore := make(chan struct{}, allExistConcurrency)
+
+	var waitGroup sync.WaitGroup
+	for i, check := range checks {
+		waitGroup.Add(1)
+		semaphore <- struct{}{}
+
+		go func(i int, check ExistenceCheck) {
+			defer waitGroup.Done()
+			defer func() { <-semaphore }()
+
+			results[i] = objectExists(clientset, check)
+		}(i, check)
+	}
+
+	waitGroup.Wait()
+
+	missing := make([]string, 0)
+	for i, check := range checks {
+		if results[i] != nil {
+			missing = append(missing, fmt.Sprintf("%v '%v' (%v)", check.Kind, check.Name, results[i]))
+		}
+	}
+
+	if len(missing) == 0 {
+		t.Logf("All %v objects exist.", len(checks))
+	} else {
+		t.Errorf(
+			"%v of %v objects do not exist: %v.",
+			len(missing),
+			len(checks),
+			strings.Join(missing, ", "),
+		)
+	}
+}
+
+// objectExists retrieves the object identified by an existence check, returning an error if it couldn't be retrieved
+// or its kind isn't supported.
+func objectExists(clientset kubernetes.Interface, check ExistenceCheck) error {
+	var err error
+	options := v1meta.GetOptions{}
+
+	switch check.Kind {
+	case "Namespace":
+		_, err = clientset.CoreV1().Namespaces().Get(check.Name, options)
+	case "Node":
+		_, err = clientset.CoreV1().Nodes().Get(check.Name, options)
+	case "StorageClass":
+		_, err = clientset.StorageV1().StorageClasses().Get(check.Name, options)
+	case "Deployment":
+		_, err = clientset.AppsV1().Deployments(check.Namespace).Get(check.Name, options)
+	case "StatefulSet":
+		_, err = clientset.AppsV1().StatefulSets(check.Namespace).Get(check.Name, options)
+	case "DaemonSet":
+		_, err = clientset.AppsV1().DaemonSets(check.Namespace).Get(check.Name, options)
+	case "Job":
+		_, err = clientset.BatchV1().Jobs(check.Namespace).Get(check.Name, options)
+	case "CronJob":
+		_, err = clientset.BatchV1beta1().CronJobs(check.Namespace).Get(check.Name, options)
+	case "Pod":
+		_, err = clientset.CoreV1().Pods(check.Namespace).Get(check.Name, options)
+	case "Service":
+		_, err = clientset.CoreV1().Services(check.Namespace).Get(check.Name, options)
+	case "ServiceAccount":
+		_, err = clientset.CoreV1().ServiceAccounts(check.Namespace).Get(check.Name, options)
+	case "ConfigMap":
+		_, err = clientset.CoreV1().ConfigMaps(check.Namespace).Get(check.Name, options)
+	case "Secret":
+		_, err = clientset.CoreV1().Secrets(check.Namespace).Get(check.Name, options)
+	case "PersistentVolumeClaim":
+		_, err = clientset.CoreV1().PersistentVolumeClaims(check.Namespace).Get(check.Name, options)
+	case "Ingress":
+		_, err = clientset.NetworkingV1beta1().Ingresses(check.Namespace).Get(check.Name, options)
+	default:
+		err = fmt.Errorf("unsupported kind %v", check.Kind)
+	}
+
+	return err
+}
+
+// progressDeadlineExceededReason is the reason the Deployment controller gives a 'Progressing' condition when a
+// rollout makes no progress within the Deployment's progress deadline.
+const progressDeadlineExceededReason = "ProgressDeadlineExceeded"
+
+// DeploymentNotStuck determines if a Deployment's rollout hasn't exceeded its progress deadline.  A stuck rollout will
+// never complete, even if the Deployment's previous replicas are still available.
+func DeploymentNotStuck(t TestingT, clientset kubernetes.Interface, name string, namespace string) {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == v1.DeploymentProgressing &&
+			condition.Status == v1core.ConditionFalse &&
+			condition.Reason == progressDeadlineExceededReason {
+			t.Errorf(
+				"Deployment '%v' exceeded its progress deadline and its rollout is stuck: %v",
+				name,
+				condition.Message,
+			)
+			return
+		}
+	}
+
+	t.Logf("Deployment '%v' has not exceeded its progress deadline.", name)
+}
+
+// ConfigMapDataEqual determines if a ConfigMap's data exactly matches an expected map.  Every key which is missing,
+// unexpected, or has the wrong value is reported in a single failure.
+func ConfigMapDataEqual(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	expected map[string]string,
+) {
+	configMap, err := clientset.CoreV1().ConfigMaps(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	differences := stringMapDifferences(configMap.Data, expected)
+
+	if len(differences) == 0 {
+		t.Logf("ConfigMap '%v' has the expected data with %v keys.", name, len(expected))
+	} else {
+		t.Errorf(
+			"ConfigMap '%v' does not have the expected data.  Differences: %v.",
+			name,
+			strings.Join(differences, ", "),
+		)
+	}
+}
+
+// DeploymentReadyReplicas polls a cluster with Eventually until a Deployment has the expected number of ready replicas
+// or a timeout is reached, waiting an interval between each attempt.  The number of ready replicas is logged on each
+// attempt to show the progression of the rollout.
+func DeploymentReadyReplicas(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	expected int32,
+	timeout time.Duration,
+	interval time.Duration,
+) {
+	Eventually(t, timeout, interval, func() error {
+		deployment, err := clientset.AppsV1().Deployments(namespace).Get(name, v1meta.GetOptions{})
+
+		if err != nil {
+			return err
+		}
+
+		readyReplicas := deployment.Status.ReadyReplicas
+		t.Logf("Deployment '%v' has %v of %v expected ready replicas.", name, readyReplicas, expected)
+
+		if readyReplicas != expected {
+			return fmt.Errorf(
+				"deployment '%v' has an unexpected number of ready replicas, expected %v, got %v",
+				name,
+				expected,
+				readyReplicas,
+			)
+		}
+
+		return nil
+	})
+}
+
+// PodContainerCount determines if a pod has the expected number of containers, including any sidecars injected when
+// the pod was created.  Init containers aren't counted.
+func PodContainerCount(t TestingT, clientset kubernetes.Interface, name string, namespace string, expectedCount int) {
+	pod, err := clientset.CoreV1().Pods(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	podContainerCountAsExpected(t, name, "containers", expectedCount, pod.Spec.Containers)
+}
+
+// PodInitContainerCount determines if a pod has the expected number of init containers.
+func PodInitContainerCount(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	expectedCount int,
+) {
+	pod, err := clientset.CoreV1().Pods(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	podContainerCountAsExpected(t, name, "init containers", expectedCount, pod.Spec.InitContainers)
+}
+
+// podContainerCountAsExpected performs appropriate logging when comparing the number of containers in a pod to its
+// expected value.  The names of the containers are logged on failure.
+func podContainerCountAsExpected(
+	t TestingT,
+	name string,
+	description string,
+	expectedCount int,
+	containers []v1core.Container,
+) {
+	containerNames := make([]string, 0, len(containers))
+	for _, container := range containers {
+		containerNames = append(containerNames, container.Name)
+	}
+
+	var containerCount = len(containers)
+	if containerCount == expectedCount {
+		t.Logf(
+			"Pod '%v' has the expected number of %v.  Expected %v, got %v.",
+			name,
+			description,
+			expectedCount,
+			containerCount,
+		)
+	} else {
+		t.Errorf(
+			"Pod '%v' has an unexpected number of %v.  Expected %v, got %v.  Containers found: %v.",
+			name,
+			description,
+			expectedCount,
+			containerCount,
+			containerNames,
+		)
+	}
+}
+
+// PodContainerImageEquals determines if a container in a live pod uses the expected image.  Unlike checks against a
+// Deployment's pod template, this includes sidecar containers injected by a mutating webhook when the pod was created.
+func PodContainerImageEquals(
+	t TestingT,
+	clientset kubernetes.Interface,
+	name string,
+	namespace string,
+	containerName string,
+	expectedImage string,
+) {
+	pod, err := clientset.CoreV1().Pods(namespace).Get(name, v1meta.GetOptions{})
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	images := make([]string, 0, len(pod.Spec.Containers))
+	for _, container := range pod.Spec.Containers {
+		images = append(images, fmt.Sprintf("%v=%v", container.Name, container.Image))
+	}
+
+	container := findContainer(pod.Spec.Containers, containerName)
+
+	if container == nil {
+		t.Errorf(
+			"Pod '%v' does not have a container named '%v'.  Container images found: %v.",
+			name,
+			containerName,
+			images,
+		)
+	} else if container.Image == expectedImage {
+		t.Logf(
+			"Container '%v' in pod '%v' has the expected image.  Expected %v, got %v.",
+			containerName,
+			name,
+			expectedImage,
+			container.Image,
+		)
+	} else {
+		t.Errorf(
+			"Container '%v' in pod '%v' does not have the expected image.  Expected %v, got %v.  "+
+				"Container images found: %v.",
+			containerName,
+			name,
+			expectedImage,
+			container.Image,
+			images,
+		)
+	}
+}
+
+// NamespaceTerminating determines if a Namespace is in the 'Terminating' phase, meaning it was deleted and its
+// finalizers are still running.  A Namespace which no longer exists is not considered terminating.
+func NamespaceTerminating(t TestingT, clientset kubernetes.Interface, name string) {
+	namespace, err := clientset.CoreV1().Namespaces().Get(name, v1meta.GetOptions{})
+
+	if k8serrors.IsNotFound(err) {
+		t.Errorf("Namespace '%v' is not terminating because it no longer exists.", name)
+		return
+	}
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	phase := namespace.Status.Phase
+	if phase == v1core.NamespaceTerminating {
+		t.Logf("Namespace '%v' is terminating.  Expected %v, got %v.", name, v1core.NamespaceTerminating, phase)
+	} else {
+		t.Errorf("Namespace '%v' is not terminating.  Expected %v, got %v.", name, v1core.NamespaceTerminating, phase)
+	}
+}
+
+// NamespaceDeleted determines if a Namespace no longer exists in a cluster.  Unlike WaitForNamespaceDeleted, the
+// Namespace is only checked once.
+func NamespaceDeleted(t TestingT, clientset kubernetes.Interface, name string) {
+	namespace, err := clientset.CoreV1().Namespaces().Get(name, v1meta.GetOptions{})
+
+	if k8serrors.IsNotFound(err) {
+		t.Logf("Namespace '%v' was deleted.", name)
+		return
+	}
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	t.Errorf("Namespace '%v' still exists.  Phase %v.", name, namespace.Status.Phase)
+}
+
+// WebhookFailurePolicy determines if an admission webhook has the expected failure policy.  A 'Fail' policy rejects
+// requests when the webhook can't be called, enforcing it, while an 'Ignore' policy allows them.  The webhook is looked
+// up by name in a MutatingWebhookConfiguration, or in a ValidatingWebhookConfiguration if no mutating configuration
+// with the given name exists.  A webhook that doesn't set its failure policy defaults to 'Fail'.
+func WebhookFailurePolicy(
+	t TestingT,
+	clientset kubernetes.Interface,
+	configName string,
+	webhookName string,
+	expected v1admissionregistration.FailurePolicyType,
+) {
+	webhook, exists := findWebhooks(clientset, configName)[webhookName]
+
+	if !exists {
+		t.Errorf("Webhook configuration '%v' does not have a webhook named '%v'.", configName, webhookName)
+		return
+	}
+
+	failurePolicy := v1admissionregistration.Fail
+	if webhook.FailurePolicy != nil {
+		failurePolicy = *webhook.FailurePolicy
+	}
+
+	if failurePolicy == expected {
+		t.Logf(
+			"Webhook '%v' has the expected failure policy.  Expected %v, got %v.",
+			webhookName,
+			expected,
+			failurePolicy,
+		)
+	} else {
+		t.Errorf(
+			"Webhook '%v' does not have the expected failure policy.  Expected %v, got %v.",
+			webhookName,
+			expected,
+			failurePolicy,
+		)
+	}
+}
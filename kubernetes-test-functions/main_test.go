@@ -0,0 +1,1755 @@
+/**
+ * Unit tests for the reusable Kubernetes test functions.  Objects are seeded into fake clients, and each assertion is
+ * run against a recording TestingT to confirm it passes or fails as expected.
+ */
+
+package kubernetes_test_functions
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	v1admissionregistration "k8s.io/api/admissionregistration/v1"
+	v1 "k8s.io/api/apps/v1"
+	v1autoscaling "k8s.io/api/autoscaling/v1"
+	v1batch "k8s.io/api/batch/v1"
+	v1beta1batch "k8s.io/api/batch/v1beta1"
+	v1core "k8s.io/api/core/v1"
+	v1beta1discovery "k8s.io/api/discovery/v1beta1"
+	v1beta1networking "k8s.io/api/networking/v1beta1"
+	v1beta1policy "k8s.io/api/policy/v1beta1"
+	v1rbac "k8s.io/api/rbac/v1"
+	v1scheduling "k8s.io/api/scheduling/v1"
+	v1storage "k8s.io/api/storage/v1"
+	v1apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+)
+
+const testNamespace = "default"
+
+// recordingT is a TestingT which records every message logged by an assertion instead of reporting it.
+type recordingT struct {
+	logs   []string
+	errors []string
+	fatals []string
+}
+
+func (r *recordingT) Logf(format string, args ...interface{}) {
+	r.logs = append(r.logs, fmt.Sprintf(format, args...))
+}
+
+func (r *recordingT) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recordingT) Fatalf(format string, args ...interface{}) {
+	r.fatals = append(r.fatals, fmt.Sprintf(format, args...))
+}
+
+// failed determines if an assertion reported a failure with Errorf or Fatalf.
+func (r *recordingT) failed() bool {
+	return len(r.errors) > 0 || len(r.fatals) > 0
+}
+
+// expectPass runs an assertion against a recordingT and fails the test if the assertion reported a failure or didn't
+// log a success message.
+func expectPass(t *testing.T, assertion func(r TestingT)) *recordingT {
+	t.Helper()
+	recorder := &recordingT{}
+	assertion(recorder)
+
+	if recorder.failed() {
+		t.Errorf("Expected the assertion to pass, got failures: %v %v.", recorder.errors, recorder.fatals)
+	} else if len(recorder.logs) == 0 {
+		t.Errorf("Expected the assertion to log a success message, got nothing.")
+	}
+
+	return recorder
+}
+
+// expectFail runs an assertion against a recordingT and fails the test if the assertion didn't report a failure.
+func expectFail(t *testing.T, assertion func(r TestingT)) *recordingT {
+	t.Helper()
+	recorder := &recordingT{}
+	assertion(recorder)
+
+	if !recorder.failed() {
+		t.Errorf("Expected the assertion to fail, got logs: %v.", recorder.logs)
+	}
+
+	return recorder
+}
+
+// expectPanic runs an assertion against a recordingT and fails the test if the assertion didn't panic.  Assertions
+// panic when the object they retrieve doesn't exist.
+func expectPanic(t *testing.T, assertion func(r TestingT)) {
+	t.Helper()
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected the assertion to panic, got no panic.")
+		}
+	}()
+
+	assertion(&recordingT{})
+}
+
+// expectMessageContains fails the test if none of the messages contain a substring.
+func expectMessageContains(t *testing.T, messages []string, substring string) {
+	t.Helper()
+
+	for _, message := range messages {
+		if strings.Contains(message, substring) {
+			return
+		}
+	}
+
+	t.Errorf("Expected a message containing '%v', got %v.", substring, messages)
+}
+
+func int32Pointer(value int32) *int32 {
+	return &value
+}
+
+func int64Pointer(value int64) *int64 {
+	return &value
+}
+
+func boolPointer(value bool) *bool {
+	return &value
+}
+
+func stringPointer(value string) *string {
+	return &value
+}
+
+// futureTimestamp returns a creation timestamp in the future, which existence checks don't consider created yet.
+func futureTimestamp() v1meta.Time {
+	return v1meta.NewTime(time.Now().Add(time.Hour))
+}
+
+// testObjectMeta returns the metadata of a namespaced object in the test namespace.
+func testObjectMeta(name string) v1meta.ObjectMeta {
+	return v1meta.ObjectMeta{Name: name, Namespace: testNamespace}
+}
+
+// testDeployment returns a Deployment named 'web' with a single 'web' container and two replicas.
+func testDeployment() *v1.Deployment {
+	labels := map[string]string{"app": "web"}
+
+	return &v1.Deployment{
+		ObjectMeta: v1meta.ObjectMeta{Name: "web", Namespace: testNamespace, Labels: labels},
+		Spec: v1.DeploymentSpec{
+			Replicas: int32Pointer(2),
+			Selector: &v1meta.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			Template: v1core.PodTemplateSpec{
+				ObjectMeta: v1meta.ObjectMeta{Labels: map[string]string{"app": "web"}},
+				Spec: v1core.PodSpec{
+					Containers: []v1core.Container{{Name: "web", Image: "nginx:1.19"}},
+				},
+			},
+		},
+	}
+}
+
+// testPod returns a running pod with a given name, labeled 'app=web'.
+func testPod(name string) *v1core.Pod {
+	return &v1core.Pod{
+		ObjectMeta: v1meta.ObjectMeta{Name: name, Namespace: testNamespace, Labels: map[string]string{"app": "web"}},
+		Spec: v1core.PodSpec{
+			Containers: []v1core.Container{{Name: "web", Image: "nginx:1.19"}},
+		},
+		Status: v1core.PodStatus{
+			Phase:             v1core.PodRunning,
+			ContainerStatuses: []v1core.ContainerStatus{{Name: "web", Ready: true}},
+		},
+	}
+}
+
+// testUnstructured returns an unstructured object for the fake dynamic client.
+func testUnstructured(
+	apiVersion string,
+	kind string,
+	name string,
+	namespace string,
+	fields map[string]interface{},
+) *unstructured.Unstructured {
+	object := map[string]interface{}{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata":   map[string]interface{}{"name": name, "namespace": namespace},
+	}
+
+	for key, value := range fields {
+		object[key] = value
+	}
+
+	return &unstructured.Unstructured{Object: object}
+}
+
+func TestExpectedDeploymentCount(t *testing.T) {
+	clientset := fake.NewSimpleClientset(testDeployment())
+
+	expectPass(t, func(r TestingT) { ExpectedDeploymentCount(r, clientset, testNamespace, 1) })
+	expectFail(t, func(r TestingT) { ExpectedDeploymentCount(r, clientset, testNamespace, 2) })
+}
+
+func TestDeploymentExists(t *testing.T) {
+	clientset := fake.NewSimpleClientset(testDeployment())
+
+	expectPass(t, func(r TestingT) { DeploymentExists(r, clientset, "web", testNamespace) })
+	expectPanic(t, func(r TestingT) { DeploymentExists(r, clientset, "missing", testNamespace) })
+}
+
+func TestDeploymentStatusCheck(t *testing.T) {
+	deployment := testDeployment()
+	deployment.Status = v1.DeploymentStatus{
+		Replicas:          2,
+		AvailableReplicas: 2,
+		ReadyReplicas:     2,
+		Conditions: []v1.DeploymentCondition{
+			{Type: v1.DeploymentAvailable, Status: v1core.ConditionTrue},
+			{Type: v1.DeploymentProgressing, Status: v1core.ConditionTrue},
+		},
+	}
+	clientset := fake.NewSimpleClientset(deployment)
+
+	statusCheck := func(isAvailable bool, expectedTotalReplicas int32) func(r TestingT) {
+		return func(r TestingT) {
+			DeploymentStatusCheck(r, clientset, "web", testNamespace, isAvailable, true, expectedTotalReplicas, 2, 2, 0)
+		}
+	}
+
+	expectPass(t, statusCheck(true, 2))
+	expectFail(t, statusCheck(false, 2))
+	expectFail(t, statusCheck(true, 3))
+}
+
+func TestDeploymentStrategyTypeEquals(t *testing.T) {
+	deployment := testDeployment()
+	deployment.Spec.Strategy.Type = v1.RecreateDeploymentStrategyType
+	clientset := fake.NewSimpleClientset(deployment)
+
+	expectPass(t, func(r TestingT) {
+		DeploymentStrategyTypeEquals(r, clientset, "web", testNamespace, v1.RecreateDeploymentStrategyType)
+	})
+	expectFail(t, func(r TestingT) {
+		DeploymentStrategyTypeEquals(r, clientset, "web", testNamespace, v1.RollingUpdateDeploymentStrategyType)
+	})
+}
+
+func TestDeploymentRollingUpdateParams(t *testing.T) {
+	maxSurge := intstr.FromString("25%")
+	maxUnavailable := intstr.FromInt(0)
+	deployment := testDeployment()
+	deployment.Spec.Strategy = v1.DeploymentStrategy{
+		Type:          v1.RollingUpdateDeploymentStrategyType,
+		RollingUpdate: &v1.RollingUpdateDeployment{MaxSurge: &maxSurge, MaxUnavailable: &maxUnavailable},
+	}
+	clientset := fake.NewSimpleClientset(deployment)
+
+	expectPass(t, func(r TestingT) {
+		DeploymentRollingUpdateParams(r, clientset, "web", testNamespace, maxSurge, maxUnavailable)
+	})
+	expectFail(t, func(r TestingT) {
+		DeploymentRollingUpdateParams(r, clientset, "web", testNamespace, intstr.FromInt(1), maxUnavailable)
+	})
+}
+
+func TestContainerProbes(t *testing.T) {
+	probe := &v1core.Probe{
+		Handler: v1core.Handler{
+			HTTPGet: &v1core.HTTPGetAction{Path: "/healthz", Port: intstr.FromInt(8080)},
+		},
+	}
+	deployment := testDeployment()
+	deployment.Spec.Template.Spec.Containers = []v1core.Container{
+		{Name: "web", LivenessProbe: probe, ReadinessProbe: probe},
+		{Name: "sidecar"},
+	}
+	clientset := fake.NewSimpleClientset(deployment)
+	port := intstr.FromInt(8080)
+
+	expectPass(t, func(r TestingT) { ContainerHasLivenessProbe(r, clientset, "web", testNamespace, "web") })
+	expectFail(t, func(r TestingT) { ContainerHasLivenessProbe(r, clientset, "web", testNamespace, "sidecar") })
+	expectPass(t, func(r TestingT) { ContainerHasReadinessProbe(r, clientset, "web", testNamespace, "web") })
+	expectFail(t, func(r TestingT) { ContainerHasReadinessProbe(r, clientset, "web", testNamespace, "sidecar") })
+
+	expectPass(t, func(r TestingT) {
+		ContainerLivenessProbeHTTPGet(r, clientset, "web", testNamespace, "web", "/healthz", port)
+	})
+	expectFail(t, func(r TestingT) {
+		ContainerLivenessProbeHTTPGet(r, clientset, "web", testNamespace, "web", "/ready", port)
+	})
+	expectPass(t, func(r TestingT) {
+		ContainerReadinessProbeHTTPGet(r, clientset, "web", testNamespace, "web", "/healthz", port)
+	})
+	expectFail(t, func(r TestingT) {
+		ContainerReadinessProbeHTTPGet(r, clientset, "web", testNamespace, "web", "/healthz", intstr.FromInt(80))
+	})
+}
+
+func TestDeploymentConditionStatus(t *testing.T) {
+	deployment := testDeployment()
+	deployment.Status.Conditions = []v1.DeploymentCondition{
+		{Type: v1.DeploymentAvailable, Status: v1core.ConditionTrue},
+	}
+	clientset := fake.NewSimpleClientset(deployment)
+
+	expectPass(t, func(r TestingT) {
+		DeploymentConditionStatus(r, clientset, "web", testNamespace, v1.DeploymentAvailable, v1core.ConditionTrue)
+	})
+	expectFail(t, func(r TestingT) {
+		DeploymentConditionStatus(r, clientset, "web", testNamespace, v1.DeploymentAvailable, v1core.ConditionFalse)
+	})
+	expectFail(t, func(r TestingT) {
+		DeploymentConditionStatus(r, clientset, "web", testNamespace, v1.DeploymentProgressing, v1core.ConditionTrue)
+	})
+}
+
+func TestDeploymentHasImagePullSecret(t *testing.T) {
+	deployment := testDeployment()
+	deployment.Spec.Template.Spec.ImagePullSecrets = []v1core.LocalObjectReference{{Name: "registry"}}
+	clientset := fake.NewSimpleClientset(deployment)
+
+	expectPass(t, func(r TestingT) { DeploymentHasImagePullSecret(r, clientset, "web", testNamespace, "registry") })
+	expectFail(t, func(r TestingT) { DeploymentHasImagePullSecret(r, clientset, "web", testNamespace, "other") })
+}
+
+func TestServiceAccountHasImagePullSecret(t *testing.T) {
+	serviceAccount := &v1core.ServiceAccount{
+		ObjectMeta:       testObjectMeta("web"),
+		ImagePullSecrets: []v1core.LocalObjectReference{{Name: "registry"}},
+	}
+	clientset := fake.NewSimpleClientset(serviceAccount)
+
+	expectPass(t, func(r TestingT) { ServiceAccountHasImagePullSecret(r, clientset, "web", testNamespace, "registry") })
+	expectFail(t, func(r TestingT) { ServiceAccountHasImagePullSecret(r, clientset, "web", testNamespace, "other") })
+}
+
+func TestDeploymentNodeSelectorEquals(t *testing.T) {
+	deployment := testDeployment()
+	deployment.Spec.Template.Spec.NodeSelector = map[string]string{"disk": "ssd"}
+	clientset := fake.NewSimpleClientset(deployment)
+
+	expectPass(t, func(r TestingT) {
+		DeploymentNodeSelectorEquals(r, clientset, "web", testNamespace, map[string]string{"disk": "ssd"})
+	})
+	expectFail(t, func(r TestingT) {
+		DeploymentNodeSelectorEquals(r, clientset, "web", testNamespace, map[string]string{"disk": "hdd"})
+	})
+}
+
+func TestDeploymentHasVolumeMount(t *testing.T) {
+	deployment := testDeployment()
+	deployment.Spec.Template.Spec.Containers[0].VolumeMounts = []v1core.VolumeMount{
+		{Name: "config", MountPath: "/etc/web"},
+	}
+	clientset := fake.NewSimpleClientset(deployment)
+
+	expectPass(t, func(r TestingT) {
+		DeploymentHasVolumeMount(r, clientset, "web", testNamespace, "web", "config", "/etc/web")
+	})
+	expectFail(t, func(r TestingT) {
+		DeploymentHasVolumeMount(r, clientset, "web", testNamespace, "web", "config", "/etc/other")
+	})
+}
+
+func TestDeploymentHasVolume(t *testing.T) {
+	deployment := testDeployment()
+	deployment.Spec.Template.Spec.Volumes = []v1core.Volume{{Name: "config"}}
+	clientset := fake.NewSimpleClientset(deployment)
+
+	expectPass(t, func(r TestingT) { DeploymentHasVolume(r, clientset, "web", testNamespace, "config") })
+	expectFail(t, func(r TestingT) { DeploymentHasVolume(r, clientset, "web", testNamespace, "data") })
+}
+
+func TestDeploymentHasToleration(t *testing.T) {
+	tests := []struct {
+		name       string
+		toleration v1core.Toleration
+		tolerates  bool
+	}{
+		{
+			name: "equal operator with a matching value",
+			toleration: v1core.Toleration{
+				Key:      "dedicated",
+				Operator: v1core.TolerationOpEqual,
+				Value:    "web",
+				Effect:   v1core.TaintEffectNoSchedule,
+			},
+			tolerates: true,
+		},
+		{
+			name:       "empty operator defaults to equal",
+			toleration: v1core.Toleration{Key: "dedicated", Value: "web", Effect: v1core.TaintEffectNoSchedule},
+			tolerates:  true,
+		},
+		{
+			name: "equal operator with a different value",
+			toleration: v1core.Toleration{
+				Key:      "dedicated",
+				Operator: v1core.TolerationOpEqual,
+				Value:    "api",
+				Effect:   v1core.TaintEffectNoSchedule,
+			},
+			tolerates: false,
+		},
+		{
+			name: "exists operator ignores the value",
+			toleration: v1core.Toleration{
+				Key: "dedicated", Operator: v1core.TolerationOpExists, Effect: v1core.TaintEffectNoSchedule,
+			},
+			tolerates: true,
+		},
+		{
+			name:       "exists operator with an empty key matches any key",
+			toleration: v1core.Toleration{Operator: v1core.TolerationOpExists},
+			tolerates:  true,
+		},
+		{
+			name:       "empty effect matches any effect",
+			toleration: v1core.Toleration{Key: "dedicated", Operator: v1core.TolerationOpEqual, Value: "web"},
+			tolerates:  true,
+		},
+		{
+			name: "different effect",
+			toleration: v1core.Toleration{
+				Key: "dedicated", Operator: v1core.TolerationOpEqual, Value: "web", Effect: v1core.TaintEffectNoExecute,
+			},
+			tolerates: false,
+		},
+		{
+			name: "different key",
+			toleration: v1core.Toleration{
+				Key: "gpu", Operator: v1core.TolerationOpExists, Effect: v1core.TaintEffectNoSchedule,
+			},
+			tolerates: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deployment := testDeployment()
+			deployment.Spec.Template.Spec.Tolerations = []v1core.Toleration{test.toleration}
+			clientset := fake.NewSimpleClientset(deployment)
+
+			assertion := func(r TestingT) {
+				DeploymentHasToleration(
+					r, clientset, "web", testNamespace, "dedicated", "web", v1core.TaintEffectNoSchedule,
+				)
+			}
+
+			if test.tolerates {
+				expectPass(t, assertion)
+			} else {
+				expectFail(t, assertion)
+			}
+		})
+	}
+}
+
+func TestDeploymentFullyReady(t *testing.T) {
+	ready := testDeployment()
+	ready.Status = v1.DeploymentStatus{ReadyReplicas: 2, UpdatedReplicas: 2}
+	updating := testDeployment()
+	updating.Name = "updating"
+	updating.Status = v1.DeploymentStatus{ReadyReplicas: 2, UpdatedReplicas: 1}
+	clientset := fake.NewSimpleClientset(ready, updating)
+
+	expectPass(t, func(r TestingT) { DeploymentFullyReady(r, clientset, "web", testNamespace) })
+	expectFail(t, func(r TestingT) { DeploymentFullyReady(r, clientset, "updating", testNamespace) })
+}
+
+func TestDeploymentSelectorMatchesLabels(t *testing.T) {
+	matching := testDeployment()
+	mismatched := testDeployment()
+	mismatched.Name = "mismatched"
+	mismatched.Spec.Template.Labels = map[string]string{"app": "api"}
+	clientset := fake.NewSimpleClientset(matching, mismatched)
+
+	expectPass(t, func(r TestingT) { DeploymentSelectorMatchesLabels(r, clientset, "web", testNamespace) })
+	expectFail(t, func(r TestingT) { DeploymentSelectorMatchesLabels(r, clientset, "mismatched", testNamespace) })
+}
+
+func TestDeploymentGenerationObserved(t *testing.T) {
+	observed := testDeployment()
+	observed.Generation = 3
+	observed.Status.ObservedGeneration = 3
+	stale := testDeployment()
+	stale.Name = "stale"
+	stale.Generation = 3
+	stale.Status.ObservedGeneration = 2
+	clientset := fake.NewSimpleClientset(observed, stale)
+
+	expectPass(t, func(r TestingT) { DeploymentGenerationObserved(r, clientset, "web", testNamespace) })
+	expectFail(t, func(r TestingT) { DeploymentGenerationObserved(r, clientset, "stale", testNamespace) })
+}
+
+func TestDeploymentContainerSecurityContext(t *testing.T) {
+	deployment := testDeployment()
+	deployment.Spec.Template.Spec.Containers = []v1core.Container{
+		{
+			Name: "web",
+			SecurityContext: &v1core.SecurityContext{
+				RunAsNonRoot:           boolPointer(true),
+				ReadOnlyRootFilesystem: boolPointer(true),
+			},
+		},
+		{Name: "sidecar"},
+	}
+	clientset := fake.NewSimpleClientset(deployment)
+
+	expectPass(t, func(r TestingT) { ContainerRunsAsNonRoot(r, clientset, "web", testNamespace, "web") })
+	expectFail(t, func(r TestingT) { ContainerRunsAsNonRoot(r, clientset, "web", testNamespace, "sidecar") })
+	expectPass(t, func(r TestingT) { ContainerReadOnlyRootFilesystem(r, clientset, "web", testNamespace, "web") })
+	expectFail(t, func(r TestingT) { ContainerReadOnlyRootFilesystem(r, clientset, "web", testNamespace, "sidecar") })
+}
+
+func TestDeploymentReplicasInRange(t *testing.T) {
+	deployment := testDeployment()
+	deployment.Status.ReadyReplicas = 2
+	clientset := fake.NewSimpleClientset(deployment)
+
+	expectPass(t, func(r TestingT) { DeploymentReplicasInRange(r, clientset, "web", testNamespace, 1, 3) })
+	expectFail(t, func(r TestingT) { DeploymentReplicasInRange(r, clientset, "web", testNamespace, 3, 5) })
+}
+
+func TestDeploymentUsesServiceAccount(t *testing.T) {
+	deployment := testDeployment()
+	deployment.Spec.Template.Spec.ServiceAccountName = "web"
+	clientset := fake.NewSimpleClientset(deployment)
+
+	expectPass(t, func(r TestingT) { DeploymentUsesServiceAccount(r, clientset, "web", testNamespace, "web") })
+	expectFail(t, func(r TestingT) { DeploymentUsesServiceAccount(r, clientset, "web", testNamespace, "default") })
+}
+
+func TestDeploymentAnnotations(t *testing.T) {
+	revision := "deployment.kubernetes.io/revision"
+	cause := "kubectl set image deployment/web web=nginx:1.19"
+	deployment := testDeployment()
+	deployment.Annotations = map[string]string{revision: "4", "kubernetes.io/change-cause": cause}
+	templateOnly := testDeployment()
+	templateOnly.Name = "template-only"
+	templateOnly.Spec.Template.Annotations = map[string]string{"kubernetes.io/change-cause": cause}
+	unannotated := testDeployment()
+	unannotated.Name = "unannotated"
+	clientset := fake.NewSimpleClientset(deployment, templateOnly, unannotated)
+
+	annotationMatches := func(pattern string) func(r TestingT) {
+		return func(r TestingT) { DeploymentAnnotationMatches(r, clientset, "web", testNamespace, revision, pattern) }
+	}
+
+	expectPass(t, annotationMatches(`^\d+$`))
+	expectFail(t, annotationMatches(`^v`))
+	expectPass(t, func(r TestingT) { DeploymentHasChangeCause(r, clientset, "web", testNamespace, cause) })
+	expectFail(t, func(r TestingT) { DeploymentHasChangeCause(r, clientset, "web", testNamespace, "kubectl apply") })
+	expectPass(t, func(r TestingT) { DeploymentHasChangeCause(r, clientset, "template-only", testNamespace, cause) })
+	expectFail(t, func(r TestingT) { DeploymentHasChangeCause(r, clientset, "unannotated", testNamespace, cause) })
+}
+
+func TestExpectedDeploymentCountAllNamespaces(t *testing.T) {
+	other := testDeployment()
+	other.Namespace = "other"
+	clientset := fake.NewSimpleClientset(testDeployment(), other)
+
+	expectPass(t, func(r TestingT) { ExpectedDeploymentCountAllNamespaces(r, clientset, "app=web", 2) })
+	expectFail(t, func(r TestingT) { ExpectedDeploymentCountAllNamespaces(r, clientset, "app=web", 1) })
+}
+
+func TestDeploymentImagePullPolicyEqual(t *testing.T) {
+	deployment := testDeployment()
+	deployment.Spec.Template.Spec.Containers[0].ImagePullPolicy = v1core.PullAlways
+	clientset := fake.NewSimpleClientset(deployment)
+
+	expectPass(t, func(r TestingT) {
+		DeploymentImagePullPolicyEqual(r, clientset, "web", testNamespace, "web", v1core.PullAlways)
+	})
+	expectFail(t, func(r TestingT) {
+		DeploymentImagePullPolicyEqual(r, clientset, "web", testNamespace, "web", v1core.PullIfNotPresent)
+	})
+}
+
+func TestDeploymentTerminationGracePeriod(t *testing.T) {
+	deployment := testDeployment()
+	deployment.Spec.Template.Spec.TerminationGracePeriodSeconds = int64Pointer(60)
+	clientset := fake.NewSimpleClientset(deployment)
+
+	expectPass(t, func(r TestingT) { DeploymentTerminationGracePeriod(r, clientset, "web", testNamespace, 60) })
+	expectFail(t, func(r TestingT) { DeploymentTerminationGracePeriod(r, clientset, "web", testNamespace, 30) })
+}
+
+func TestDeploymentTemplateHasLabel(t *testing.T) {
+	clientset := fake.NewSimpleClientset(testDeployment())
+
+	expectPass(t, func(r TestingT) { DeploymentTemplateHasLabel(r, clientset, "web", testNamespace, "app", "web") })
+	expectFail(t, func(r TestingT) { DeploymentTemplateHasLabel(r, clientset, "web", testNamespace, "app", "api") })
+	expectFail(t, func(r TestingT) { DeploymentTemplateHasLabel(r, clientset, "web", testNamespace, "tier", "web") })
+}
+
+func TestDeploymentRolloutComplete(t *testing.T) {
+	complete := testDeployment()
+	complete.Generation = 2
+	complete.Status = v1.DeploymentStatus{ObservedGeneration: 2, UpdatedReplicas: 2}
+	rolling := testDeployment()
+	rolling.Name = "rolling"
+	rolling.Generation = 2
+	rolling.Status = v1.DeploymentStatus{ObservedGeneration: 2, UpdatedReplicas: 1}
+	clientset := fake.NewSimpleClientset(complete, rolling)
+
+	expectPass(t, func(r TestingT) { DeploymentRolloutComplete(r, clientset, "web", testNamespace) })
+	expectFail(t, func(r TestingT) { DeploymentRolloutComplete(r, clientset, "rolling", testNamespace) })
+}
+
+func TestContainerCommandAndArgs(t *testing.T) {
+	deployment := testDeployment()
+	deployment.Spec.Template.Spec.Containers[0].Command = []string{"nginx"}
+	deployment.Spec.Template.Spec.Containers[0].Args = []string{"-g", "daemon off;"}
+	clientset := fake.NewSimpleClientset(deployment)
+
+	expectPass(t, func(r TestingT) {
+		ContainerCommandEquals(r, clientset, "web", testNamespace, "web", []string{"nginx"})
+	})
+	expectFail(t, func(r TestingT) {
+		ContainerCommandEquals(r, clientset, "web", testNamespace, "web", []string{"httpd"})
+	})
+	expectPass(t, func(r TestingT) {
+		ContainerArgsEquals(r, clientset, "web", testNamespace, "web", []string{"-g", "daemon off;"})
+	})
+	expectFail(t, func(r TestingT) {
+		ContainerArgsEquals(r, clientset, "web", testNamespace, "web", []string{"-g"})
+	})
+}
+
+func TestPodSecurityContext(t *testing.T) {
+	pod := testPod("web")
+	pod.Spec.SecurityContext = &v1core.PodSecurityContext{
+		RunAsUser: int64Pointer(1000),
+		FSGroup:   int64Pointer(2000),
+	}
+	clientset := fake.NewSimpleClientset(pod)
+
+	expectPass(t, func(r TestingT) { PodSecurityContextRunAsUser(r, clientset, "web", testNamespace, 1000) })
+	expectFail(t, func(r TestingT) { PodSecurityContextRunAsUser(r, clientset, "web", testNamespace, 0) })
+	expectPass(t, func(r TestingT) { PodSecurityContextFSGroup(r, clientset, "web", testNamespace, 2000) })
+	expectFail(t, func(r TestingT) { PodSecurityContextFSGroup(r, clientset, "web", testNamespace, 1000) })
+}
+
+func TestDeploymentAvailabilityAtLeast(t *testing.T) {
+	deployment := testDeployment()
+	deployment.Status.AvailableReplicas = 1
+	clientset := fake.NewSimpleClientset(deployment)
+
+	expectPass(t, func(r TestingT) { DeploymentAvailabilityAtLeast(r, clientset, "web", testNamespace, 50) })
+	expectFail(t, func(r TestingT) { DeploymentAvailabilityAtLeast(r, clientset, "web", testNamespace, 75) })
+}
+
+func TestDeploymentHasPodAntiAffinity(t *testing.T) {
+	deployment := testDeployment()
+	deployment.Spec.Template.Spec.Affinity = &v1core.Affinity{
+		PodAntiAffinity: &v1core.PodAntiAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []v1core.PodAffinityTerm{
+				{TopologyKey: "kubernetes.io/hostname"},
+			},
+		},
+	}
+	clientset := fake.NewSimpleClientset(deployment)
+
+	expectPass(t, func(r TestingT) {
+		DeploymentHasPodAntiAffinity(r, clientset, "web", testNamespace, "kubernetes.io/hostname")
+	})
+	expectFail(t, func(r TestingT) {
+		DeploymentHasPodAntiAffinity(r, clientset, "web", testNamespace, "topology.kubernetes.io/zone")
+	})
+}
+
+func TestDeploymentNotStuck(t *testing.T) {
+	progressing := testDeployment()
+	progressing.Status.Conditions = []v1.DeploymentCondition{
+		{Type: v1.DeploymentProgressing, Status: v1core.ConditionTrue, Reason: "NewReplicaSetAvailable"},
+	}
+	stuck := testDeployment()
+	stuck.Name = "stuck"
+	stuck.Status.Conditions = []v1.DeploymentCondition{
+		{Type: v1.DeploymentProgressing, Status: v1core.ConditionFalse, Reason: "ProgressDeadlineExceeded"},
+	}
+	clientset := fake.NewSimpleClientset(progressing, stuck)
+
+	expectPass(t, func(r TestingT) { DeploymentNotStuck(r, clientset, "web", testNamespace) })
+	expectFail(t, func(r TestingT) { DeploymentNotStuck(r, clientset, "stuck", testNamespace) })
+}
+
+func TestDeploymentReadyReplicas(t *testing.T) {
+	deployment := testDeployment()
+	deployment.Status.ReadyReplicas = 2
+	clientset := fake.NewSimpleClientset(deployment)
+
+	expectPass(t, func(r TestingT) {
+		DeploymentReadyReplicas(r, clientset, "web", testNamespace, 2, 50*time.Millisecond, time.Millisecond)
+	})
+	recorder := expectFail(t, func(r TestingT) {
+		DeploymentReadyReplicas(r, clientset, "web", testNamespace, 3, 50*time.Millisecond, 10*time.Millisecond)
+	})
+
+	if len(recorder.logs) < 2 {
+		t.Errorf("Expected the ready replica count to be retried, got %v attempt(s).", len(recorder.logs))
+	}
+}
+
+func TestAnnotationsEqual(t *testing.T) {
+	annotations := map[string]string{"owner": "andy"}
+
+	expectPass(t, func(r TestingT) { AnnotationsEqual(r, annotations, "owner", "andy") })
+	expectFail(t, func(r TestingT) { AnnotationsEqual(r, annotations, "owner", "jarombek") })
+}
+
+func TestAnnotationsMatchPattern(t *testing.T) {
+	annotations := map[string]string{"version": "1.2.3"}
+
+	expectPass(t, func(r TestingT) { AnnotationsMatchPattern(r, annotations, "version", `^\d+\.\d+\.\d+$`) })
+	expectFail(t, func(r TestingT) { AnnotationsMatchPattern(r, annotations, "version", `^v`) })
+}
+
+func TestAnnotationAbsent(t *testing.T) {
+	annotations := map[string]string{"owner": "andy"}
+
+	expectPass(t, func(r TestingT) { AnnotationAbsent(r, annotations, "team") })
+	expectFail(t, func(r TestingT) { AnnotationAbsent(r, annotations, "owner") })
+}
+
+func TestAnnotationsAllEqual(t *testing.T) {
+	annotations := map[string]string{"owner": "andy", "team": "platform"}
+
+	expectPass(t, func(r TestingT) { AnnotationsAllEqual(r, annotations, map[string]string{"owner": "andy"}) })
+	expectFail(t, func(r TestingT) { AnnotationsAllEqual(r, annotations, map[string]string{"team": "web"}) })
+}
+
+func TestAnnotationsExactly(t *testing.T) {
+	annotations := map[string]string{"owner": "andy", "team": "platform"}
+
+	expectPass(t, func(r TestingT) {
+		AnnotationsExactly(r, annotations, map[string]string{"owner": "andy", "team": "platform"})
+	})
+	expectFail(t, func(r TestingT) { AnnotationsExactly(r, annotations, map[string]string{"owner": "andy"}) })
+}
+
+func TestAnnotationsAddedExactly(t *testing.T) {
+	before := map[string]string{"owner": "andy"}
+	after := map[string]string{"owner": "andy", "team": "platform"}
+
+	expectPass(t, func(r TestingT) {
+		AnnotationsAddedExactly(r, before, after, map[string]string{"team": "platform"})
+	})
+	expectFail(t, func(r TestingT) {
+		AnnotationsAddedExactly(r, before, after, map[string]string{"team": "web"})
+	})
+}
+
+func TestConditionStatusMet(t *testing.T) {
+	conditions := []v1.DeploymentCondition{{Type: v1.DeploymentAvailable, Status: v1core.ConditionTrue}}
+
+	expectPass(t, func(r TestingT) { ConditionStatusMet(r, conditions, v1.DeploymentAvailable, v1core.ConditionTrue) })
+	expectFail(t, func(r TestingT) { ConditionStatusMet(r, conditions, v1.DeploymentAvailable, v1core.ConditionFalse) })
+}
+
+func TestReplicaCountAsExpected(t *testing.T) {
+	expectPass(t, func(r TestingT) { ReplicaCountAsExpected(r, 2, 2, "number of replicas") })
+	expectFail(t, func(r TestingT) { ReplicaCountAsExpected(r, 2, 1, "number of replicas") })
+}
+
+func TestCreatedWithinDuration(t *testing.T) {
+	recent := v1meta.NewTime(time.Now().Add(-time.Minute))
+	old := v1meta.NewTime(time.Now().Add(-time.Hour))
+
+	expectPass(t, func(r TestingT) { CreatedWithinDuration(r, recent, 10*time.Minute) })
+	expectFail(t, func(r TestingT) { CreatedWithinDuration(r, old, 10*time.Minute) })
+}
+
+func TestHasOwnerReference(t *testing.T) {
+	ownerRefs := []v1meta.OwnerReference{{Kind: "ReplicaSet", Name: "web-5d4f8"}}
+
+	expectPass(t, func(r TestingT) { HasOwnerReference(r, ownerRefs, "ReplicaSet", "web-5d4f8") })
+	expectFail(t, func(r TestingT) { HasOwnerReference(r, ownerRefs, "StatefulSet", "web-5d4f8") })
+}
+
+func TestLabelSelectorMatches(t *testing.T) {
+	objectLabels := map[string]string{"app": "web", "tier": "frontend"}
+
+	expectPass(t, func(r TestingT) { LabelSelectorMatches(r, objectLabels, map[string]string{"app": "web"}) })
+	expectFail(t, func(r TestingT) { LabelSelectorMatches(r, objectLabels, map[string]string{"app": "api"}) })
+}
+
+func TestQuantities(t *testing.T) {
+	if !QuantitiesEqual(resource.MustParse("1Gi"), resource.MustParse("1024Mi")) {
+		t.Errorf("Expected 1Gi to equal 1024Mi.")
+	}
+
+	if QuantitiesEqual(resource.MustParse("1G"), resource.MustParse("1Gi")) {
+		t.Errorf("Expected 1G not to equal 1Gi.")
+	}
+
+	if !QuantityAtLeast(resource.MustParse("500m"), resource.MustParse("0.5")) {
+		t.Errorf("Expected 500m to be at least 0.5.")
+	}
+
+	if QuantityAtLeast(resource.MustParse("250m"), resource.MustParse("0.5")) {
+		t.Errorf("Expected 250m not to be at least 0.5.")
+	}
+}
+
+func TestNamespaceExists(t *testing.T) {
+	active := &v1core.Namespace{
+		ObjectMeta: v1meta.ObjectMeta{Name: "web"},
+		Status:     v1core.NamespaceStatus{Phase: v1core.NamespaceActive},
+	}
+	terminating := &v1core.Namespace{
+		ObjectMeta: v1meta.ObjectMeta{Name: "old"},
+		Status:     v1core.NamespaceStatus{Phase: v1core.NamespaceTerminating},
+	}
+	clientset := fake.NewSimpleClientset(active, terminating)
+
+	expectPass(t, func(r TestingT) { NamespaceExists(r, clientset, "web") })
+	expectFail(t, func(r TestingT) { NamespaceExists(r, clientset, "old") })
+	expectPanic(t, func(r TestingT) { NamespaceExists(r, clientset, "missing") })
+}
+
+func TestNamespaceLifecycle(t *testing.T) {
+	terminating := &v1core.Namespace{
+		ObjectMeta: v1meta.ObjectMeta{Name: "old"},
+		Spec:       v1core.NamespaceSpec{Finalizers: []v1core.FinalizerName{v1core.FinalizerKubernetes}},
+		Status:     v1core.NamespaceStatus{Phase: v1core.NamespaceTerminating},
+	}
+	active := &v1core.Namespace{
+		ObjectMeta: v1meta.ObjectMeta{Name: "web"},
+		Status:     v1core.NamespaceStatus{Phase: v1core.NamespaceActive},
+	}
+	clientset := fake.NewSimpleClientset(terminating, active)
+
+	expectPass(t, func(r TestingT) { NamespaceTerminating(r, clientset, "old") })
+	expectFail(t, func(r TestingT) { NamespaceTerminating(r, clientset, "web") })
+	expectFail(t, func(r TestingT) { NamespaceTerminating(r, clientset, "missing") })
+
+	expectPass(t, func(r TestingT) { NamespaceDeleted(r, clientset, "missing") })
+	expectFail(t, func(r TestingT) { NamespaceDeleted(r, clientset, "old") })
+
+	expectPass(t, func(r TestingT) { WaitForNamespaceDeleted(r, clientset, "missing", 3, time.Millisecond) })
+	recorder := expectFail(t, func(r TestingT) { WaitForNamespaceDeleted(r, clientset, "old", 3, time.Millisecond) })
+	expectMessageContains(t, recorder.errors, "kubernetes")
+}
+
+func TestRBACExists(t *testing.T) {
+	pending := v1meta.ObjectMeta{Name: "pending", Namespace: testNamespace, CreationTimestamp: futureTimestamp()}
+	clientset := fake.NewSimpleClientset(
+		&v1core.ServiceAccount{ObjectMeta: testObjectMeta("web")},
+		&v1core.ServiceAccount{ObjectMeta: pending},
+		&v1rbac.Role{ObjectMeta: testObjectMeta("web")},
+		&v1rbac.Role{ObjectMeta: pending},
+		&v1rbac.RoleBinding{ObjectMeta: testObjectMeta("web")},
+		&v1rbac.RoleBinding{ObjectMeta: pending},
+		&v1rbac.ClusterRole{ObjectMeta: v1meta.ObjectMeta{Name: "web"}},
+		&v1rbac.ClusterRole{ObjectMeta: v1meta.ObjectMeta{Name: "pending", CreationTimestamp: futureTimestamp()}},
+		&v1rbac.ClusterRoleBinding{ObjectMeta: v1meta.ObjectMeta{Name: "web"}},
+		&v1rbac.ClusterRoleBinding{
+			ObjectMeta: v1meta.ObjectMeta{Name: "pending", CreationTimestamp: futureTimestamp()},
+		},
+	)
+
+	expectPass(t, func(r TestingT) { ServiceAccountExists(r, clientset, "web", testNamespace) })
+	expectFail(t, func(r TestingT) { ServiceAccountExists(r, clientset, "pending", testNamespace) })
+	expectPass(t, func(r TestingT) { RoleExists(r, clientset, "web", testNamespace) })
+	expectFail(t, func(r TestingT) { RoleExists(r, clientset, "pending", testNamespace) })
+	expectPass(t, func(r TestingT) { RoleBindingExists(r, clientset, "web", testNamespace) })
+	expectFail(t, func(r TestingT) { RoleBindingExists(r, clientset, "pending", testNamespace) })
+	expectPass(t, func(r TestingT) { ClusterRoleExists(r, clientset, "web") })
+	expectFail(t, func(r TestingT) { ClusterRoleExists(r, clientset, "pending") })
+	expectPass(t, func(r TestingT) { ClusterRoleBindingExists(r, clientset, "web") })
+	expectFail(t, func(r TestingT) { ClusterRoleBindingExists(r, clientset, "pending") })
+}
+
+func TestServices(t *testing.T) {
+	service := &v1core.Service{
+		ObjectMeta: testObjectMeta("web"),
+		Spec: v1core.ServiceSpec{
+			Type:                  v1core.ServiceTypeLoadBalancer,
+			Selector:              map[string]string{"app": "web"},
+			Ports:                 []v1core.ServicePort{{Name: "http", Port: 80}, {Name: "https", Port: 443}},
+			ExternalTrafficPolicy: v1core.ServiceExternalTrafficPolicyTypeLocal,
+		},
+	}
+	clientset := fake.NewSimpleClientset(service, testPod("web-1"), testPod("web-2"))
+
+	expectPass(t, func(r TestingT) { NamespaceServiceCount(r, clientset, testNamespace, 1) })
+	expectFail(t, func(r TestingT) { NamespaceServiceCount(r, clientset, testNamespace, 2) })
+
+	expectPass(t, func(r TestingT) {
+		ServiceExists(r, clientset, "web", testNamespace, v1core.ServiceTypeLoadBalancer)
+	})
+	expectFail(t, func(r TestingT) { ServiceExists(r, clientset, "web", testNamespace, v1core.ServiceTypeClusterIP) })
+
+	expectPass(t, func(r TestingT) {
+		ServiceSelectorEquals(r, clientset, "web", testNamespace, map[string]string{"app": "web"})
+	})
+	expectFail(t, func(r TestingT) {
+		ServiceSelectorEquals(r, clientset, "web", testNamespace, map[string]string{"app": "api"})
+	})
+
+	expectPass(t, func(r TestingT) { ServiceSelectsPods(r, clientset, "web", testNamespace, 2) })
+	expectFail(t, func(r TestingT) { ServiceSelectsPods(r, clientset, "web", testNamespace, 3) })
+
+	expectPass(t, func(r TestingT) { ServicePortCount(r, clientset, "web", testNamespace, 2) })
+	expectFail(t, func(r TestingT) { ServicePortCount(r, clientset, "web", testNamespace, 1) })
+
+	expectPass(t, func(r TestingT) {
+		ServiceExternalTrafficPolicyEqual(
+			r, clientset, "web", testNamespace, v1core.ServiceExternalTrafficPolicyTypeLocal,
+		)
+	})
+	expectFail(t, func(r TestingT) {
+		ServiceExternalTrafficPolicyEqual(
+			r, clientset, "web", testNamespace, v1core.ServiceExternalTrafficPolicyTypeCluster,
+		)
+	})
+}
+
+func TestIngresses(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&v1beta1networking.Ingress{ObjectMeta: testObjectMeta("web")})
+
+	expectPass(t, func(r TestingT) { NamespaceIngressCount(r, clientset, testNamespace, 1) })
+	expectFail(t, func(r TestingT) { NamespaceIngressCount(r, clientset, testNamespace, 0) })
+	expectPass(t, func(r TestingT) { IngressExists(r, clientset, testNamespace, "web") })
+	expectPanic(t, func(r TestingT) { IngressExists(r, clientset, testNamespace, "missing") })
+}
+
+func TestIngressClassNameEquals(t *testing.T) {
+	client := dynamicfake.NewSimpleDynamicClient(
+		runtime.NewScheme(),
+		testUnstructured("networking.k8s.io/v1beta1", "Ingress", "web", testNamespace, map[string]interface{}{
+			"spec": map[string]interface{}{"ingressClassName": "nginx"},
+		}),
+		testUnstructured("networking.k8s.io/v1beta1", "Ingress", "unset", testNamespace, nil),
+	)
+
+	expectPass(t, func(r TestingT) { IngressClassNameEquals(r, client, "web", testNamespace, "nginx") })
+	expectFail(t, func(r TestingT) { IngressClassNameEquals(r, client, "web", testNamespace, "alb") })
+	expectFail(t, func(r TestingT) { IngressClassNameEquals(r, client, "unset", testNamespace, "nginx") })
+}
+
+func TestPods(t *testing.T) {
+	restarting := testPod("web-2")
+	restarting.Status.ContainerStatuses = []v1core.ContainerStatus{{Name: "web", Ready: false, RestartCount: 5}}
+	pending := testPod("api-1")
+	pending.Labels = map[string]string{"app": "api"}
+	pending.Status = v1core.PodStatus{
+		Phase: v1core.PodPending,
+		ContainerStatuses: []v1core.ContainerStatus{
+			{
+				Name:  "web",
+				State: v1core.ContainerState{Waiting: &v1core.ContainerStateWaiting{Reason: "ImagePullBackOff"}},
+			},
+		},
+	}
+	lost := testPod("api-2")
+	lost.Labels = map[string]string{"app": "api"}
+	lost.Status = v1core.PodStatus{Phase: v1core.PodUnknown, Reason: "NodeLost"}
+	completed := testPod("migrate-1")
+	completed.Labels = map[string]string{"app": "migrate"}
+	completed.Status = v1core.PodStatus{Phase: v1core.PodSucceeded}
+	healthy := fake.NewSimpleClientset(testPod("web-1"), completed)
+	clientset := fake.NewSimpleClientset(testPod("web-1"), restarting, pending, lost)
+
+	expectPass(t, func(r TestingT) { ExpectedPodCount(r, clientset, testNamespace, 4) })
+	expectFail(t, func(r TestingT) { ExpectedPodCount(r, clientset, testNamespace, 3) })
+
+	expectPass(t, func(r TestingT) { PodContainersReady(r, healthy, testNamespace, "app=web") })
+	expectFail(t, func(r TestingT) { PodContainersReady(r, clientset, testNamespace, "app=web") })
+
+	expectPass(t, func(r TestingT) { PodRestartsBelow(r, healthy, testNamespace, "app=web", 3) })
+	expectFail(t, func(r TestingT) { PodRestartsBelow(r, clientset, testNamespace, "app=web", 3) })
+
+	expectPass(t, func(r TestingT) { NoUnhealthyPods(r, healthy, testNamespace) })
+	recorder := expectFail(t, func(r TestingT) { NoUnhealthyPods(r, clientset, testNamespace) })
+	expectMessageContains(t, recorder.errors, "ImagePullBackOff")
+	expectMessageContains(t, recorder.errors, "NodeLost")
+}
+
+func TestPodContainers(t *testing.T) {
+	pod := testPod("web-1")
+	pod.Spec.InitContainers = []v1core.Container{{Name: "migrate", Image: "flyway:7"}}
+	pod.Spec.Containers = append(pod.Spec.Containers, v1core.Container{Name: "sidecar", Image: "envoy:1.16"})
+	clientset := fake.NewSimpleClientset(pod)
+
+	expectPass(t, func(r TestingT) { PodContainerCount(r, clientset, "web-1", testNamespace, 2) })
+	expectFail(t, func(r TestingT) { PodContainerCount(r, clientset, "web-1", testNamespace, 1) })
+	expectPass(t, func(r TestingT) { PodInitContainerCount(r, clientset, "web-1", testNamespace, 1) })
+	expectFail(t, func(r TestingT) { PodInitContainerCount(r, clientset, "web-1", testNamespace, 0) })
+
+	expectPass(t, func(r TestingT) {
+		PodContainerImageEquals(r, clientset, "web-1", testNamespace, "sidecar", "envoy:1.16")
+	})
+	expectFail(t, func(r TestingT) {
+		PodContainerImageEquals(r, clientset, "web-1", testNamespace, "sidecar", "envoy:1.17")
+	})
+	expectFail(t, func(r TestingT) {
+		PodContainerImageEquals(r, clientset, "web-1", testNamespace, "missing", "envoy:1.16")
+	})
+}
+
+func TestNodes(t *testing.T) {
+	ready := &v1core.Node{
+		ObjectMeta: v1meta.ObjectMeta{Name: "ip-10-0-1-20", Labels: map[string]string{"pool": "general"}},
+		Status: v1core.NodeStatus{
+			Conditions: []v1core.NodeCondition{{Type: v1core.NodeReady, Status: v1core.ConditionTrue}},
+		},
+	}
+	notReady := &v1core.Node{
+		ObjectMeta: v1meta.ObjectMeta{Name: "ip-10-0-2-30", Labels: map[string]string{"pool": "spot"}},
+		Status: v1core.NodeStatus{
+			Conditions: []v1core.NodeCondition{{Type: v1core.NodeReady, Status: v1core.ConditionFalse}},
+		},
+	}
+	scheduled := testPod("web-1")
+	scheduled.Spec.NodeName = "ip-10-0-1-20"
+	misplaced := testPod("web-2")
+	misplaced.Spec.NodeName = "ip-10-0-2-30"
+	clientset := fake.NewSimpleClientset(ready, notReady, scheduled, misplaced)
+
+	expectPass(t, func(r TestingT) { ExpectedReadyNodeCount(r, clientset, 1) })
+	expectFail(t, func(r TestingT) { ExpectedReadyNodeCount(r, clientset, 2) })
+
+	expectPass(t, func(r TestingT) { NodeHasLabel(r, clientset, "ip-10-0-1-20", "pool", "general") })
+	expectFail(t, func(r TestingT) { NodeHasLabel(r, clientset, "ip-10-0-2-30", "pool", "general") })
+
+	expectPass(t, func(r TestingT) { PodScheduledOnNodePattern(r, clientset, "web-1", testNamespace, `^ip-10-0-1-`) })
+	expectFail(t, func(r TestingT) { PodScheduledOnNodePattern(r, clientset, "web-2", testNamespace, `^ip-10-0-1-`) })
+
+	placed := fake.NewSimpleClientset(ready, scheduled)
+	expectPass(t, func(r TestingT) { PodsOnNodesWithLabel(r, placed, testNamespace, "app=web", "pool", "general") })
+	recorder := expectFail(t, func(r TestingT) {
+		PodsOnNodesWithLabel(r, clientset, testNamespace, "app=web", "pool", "general")
+	})
+	expectMessageContains(t, recorder.errors, "web-2")
+}
+
+func TestStatefulSets(t *testing.T) {
+	storageClass := "gp2"
+	statefulSet := &v1.StatefulSet{
+		ObjectMeta: testObjectMeta("db"),
+		Spec: v1.StatefulSetSpec{
+			UpdateStrategy: v1.StatefulSetUpdateStrategy{
+				Type:          v1.RollingUpdateStatefulSetStrategyType,
+				RollingUpdate: &v1.RollingUpdateStatefulSetStrategy{Partition: int32Pointer(1)},
+			},
+			VolumeClaimTemplates: []v1core.PersistentVolumeClaim{
+				{
+					ObjectMeta: v1meta.ObjectMeta{Name: "data"},
+					Spec: v1core.PersistentVolumeClaimSpec{
+						StorageClassName: &storageClass,
+						Resources: v1core.ResourceRequirements{
+							Requests: v1core.ResourceList{v1core.ResourceStorage: resource.MustParse("10Gi")},
+						},
+					},
+				},
+			},
+		},
+	}
+	clientset := fake.NewSimpleClientset(statefulSet)
+	onDelete := v1.OnDeleteStatefulSetStrategyType
+	rollingUpdate := v1.RollingUpdateStatefulSetStrategyType
+
+	expectPass(t, func(r TestingT) {
+		StatefulSetUpdateStrategyEquals(r, clientset, "db", testNamespace, rollingUpdate)
+	})
+	expectFail(t, func(r TestingT) { StatefulSetUpdateStrategyEquals(r, clientset, "db", testNamespace, onDelete) })
+
+	expectPass(t, func(r TestingT) { StatefulSetRollingUpdatePartition(r, clientset, "db", testNamespace, 1) })
+	expectFail(t, func(r TestingT) { StatefulSetRollingUpdatePartition(r, clientset, "db", testNamespace, 0) })
+
+	expectPass(t, func(r TestingT) {
+		StatefulSetVolumeClaimTemplate(r, clientset, "db", testNamespace, "data", resource.MustParse("10Gi"), "gp2")
+	})
+	expectFail(t, func(r TestingT) {
+		StatefulSetVolumeClaimTemplate(r, clientset, "db", testNamespace, "data", resource.MustParse("20Gi"), "gp2")
+	})
+	expectFail(t, func(r TestingT) {
+		StatefulSetVolumeClaimTemplate(r, clientset, "db", testNamespace, "logs", resource.MustParse("10Gi"), "gp2")
+	})
+}
+
+func TestStorageClasses(t *testing.T) {
+	retain := v1core.PersistentVolumeReclaimRetain
+	waitForConsumer := v1storage.VolumeBindingWaitForFirstConsumer
+	configured := &v1storage.StorageClass{
+		ObjectMeta:        v1meta.ObjectMeta{Name: "gp2"},
+		Provisioner:       "kubernetes.io/aws-ebs",
+		ReclaimPolicy:     &retain,
+		VolumeBindingMode: &waitForConsumer,
+	}
+	defaulted := &v1storage.StorageClass{
+		ObjectMeta:  v1meta.ObjectMeta{Name: "standard", CreationTimestamp: futureTimestamp()},
+		Provisioner: "kubernetes.io/gce-pd",
+	}
+	clientset := fake.NewSimpleClientset(configured, defaulted)
+
+	expectPass(t, func(r TestingT) { StorageClassExists(r, clientset, "gp2") })
+	expectFail(t, func(r TestingT) { StorageClassExists(r, clientset, "standard") })
+
+	expectPass(t, func(r TestingT) { StorageClassProvisionerEqual(r, clientset, "gp2", "kubernetes.io/aws-ebs") })
+	expectFail(t, func(r TestingT) { StorageClassProvisionerEqual(r, clientset, "standard", "kubernetes.io/aws-ebs") })
+
+	expectPass(t, func(r TestingT) { StorageClassReclaimPolicyEqual(r, clientset, "gp2", retain) })
+	expectPass(t, func(r TestingT) {
+		StorageClassReclaimPolicyEqual(r, clientset, "standard", v1core.PersistentVolumeReclaimDelete)
+	})
+	expectFail(t, func(r TestingT) { StorageClassReclaimPolicyEqual(r, clientset, "standard", retain) })
+
+	expectPass(t, func(r TestingT) { StorageClassVolumeBindingModeEqual(r, clientset, "gp2", waitForConsumer) })
+	expectPass(t, func(r TestingT) {
+		StorageClassVolumeBindingModeEqual(r, clientset, "standard", v1storage.VolumeBindingImmediate)
+	})
+	expectFail(t, func(r TestingT) { StorageClassVolumeBindingModeEqual(r, clientset, "standard", waitForConsumer) })
+}
+
+func TestPersistentVolumeBoundToClaim(t *testing.T) {
+	claimRef := &v1core.ObjectReference{Namespace: testNamespace, Name: "data"}
+	bound := &v1core.PersistentVolume{
+		ObjectMeta: v1meta.ObjectMeta{Name: "pv-1"},
+		Spec:       v1core.PersistentVolumeSpec{ClaimRef: claimRef},
+		Status:     v1core.PersistentVolumeStatus{Phase: v1core.VolumeBound},
+	}
+	released := &v1core.PersistentVolume{
+		ObjectMeta: v1meta.ObjectMeta{Name: "pv-2"},
+		Spec:       v1core.PersistentVolumeSpec{ClaimRef: claimRef},
+		Status:     v1core.PersistentVolumeStatus{Phase: v1core.VolumeReleased},
+	}
+	clientset := fake.NewSimpleClientset(bound, released)
+
+	expectPass(t, func(r TestingT) { PersistentVolumeBoundToClaim(r, clientset, "pv-1", testNamespace, "data") })
+	expectFail(t, func(r TestingT) { PersistentVolumeBoundToClaim(r, clientset, "pv-1", testNamespace, "logs") })
+	expectFail(t, func(r TestingT) { PersistentVolumeBoundToClaim(r, clientset, "pv-2", testNamespace, "data") })
+}
+
+func TestConfigMaps(t *testing.T) {
+	configMap := &v1core.ConfigMap{
+		ObjectMeta: testObjectMeta("web"),
+		Data:       map[string]string{"nginx.conf": "worker_processes 1;"},
+		BinaryData: map[string][]byte{"favicon.ico": {0}},
+	}
+	clientset := fake.NewSimpleClientset(configMap)
+
+	expectPass(t, func(r TestingT) { ConfigMapKeyCount(r, clientset, "web", testNamespace, 2) })
+	expectFail(t, func(r TestingT) { ConfigMapKeyCount(r, clientset, "web", testNamespace, 1) })
+
+	expectPass(t, func(r TestingT) { ExpectedConfigMapCount(r, clientset, testNamespace, 1) })
+	expectFail(t, func(r TestingT) { ExpectedConfigMapCount(r, clientset, testNamespace, 2) })
+
+	expectPass(t, func(r TestingT) {
+		ConfigMapDataEqual(r, clientset, "web", testNamespace, map[string]string{"nginx.conf": "worker_processes 1;"})
+	})
+	expectFail(t, func(r TestingT) {
+		ConfigMapDataEqual(r, clientset, "web", testNamespace, map[string]string{"nginx.conf": "worker_processes 2;"})
+	})
+}
+
+func TestSecrets(t *testing.T) {
+	secret := &v1core.Secret{
+		ObjectMeta: testObjectMeta("tls"),
+		Data:       map[string][]byte{"tls.crt": []byte("certificate"), "tls.key": []byte("key")},
+	}
+	clientset := fake.NewSimpleClientset(secret)
+
+	expectPass(t, func(r TestingT) { SecretKeyCount(r, clientset, "tls", testNamespace, 2) })
+	expectFail(t, func(r TestingT) { SecretKeyCount(r, clientset, "tls", testNamespace, 3) })
+
+	expectPass(t, func(r TestingT) { ExpectedSecretCount(r, clientset, testNamespace, 1) })
+	expectFail(t, func(r TestingT) { ExpectedSecretCount(r, clientset, testNamespace, 0) })
+
+	expectPass(t, func(r TestingT) { SecretHasKeys(r, clientset, "tls", testNamespace, "tls.crt", "tls.key") })
+	recorder := expectFail(t, func(r TestingT) {
+		SecretHasKeys(r, clientset, "tls", testNamespace, "tls.crt", "ca.crt")
+	})
+
+	for _, message := range append(recorder.logs, recorder.errors...) {
+		if strings.Contains(message, "certificate") {
+			t.Errorf("Expected Secret values not to be logged, got '%v'.", message)
+		}
+	}
+}
+
+func TestImmutability(t *testing.T) {
+	client := dynamicfake.NewSimpleDynamicClient(
+		runtime.NewScheme(),
+		testUnstructured("v1", "ConfigMap", "frozen", testNamespace, map[string]interface{}{"immutable": true}),
+		testUnstructured("v1", "ConfigMap", "mutable", testNamespace, nil),
+		testUnstructured("v1", "Secret", "frozen", testNamespace, map[string]interface{}{"immutable": true}),
+		testUnstructured("v1", "Secret", "mutable", testNamespace, map[string]interface{}{"immutable": false}),
+	)
+
+	expectPass(t, func(r TestingT) { ConfigMapIsImmutable(r, client, "frozen", testNamespace, true) })
+	expectPass(t, func(r TestingT) { ConfigMapIsImmutable(r, client, "mutable", testNamespace, false) })
+	expectFail(t, func(r TestingT) { ConfigMapIsImmutable(r, client, "mutable", testNamespace, true) })
+
+	expectPass(t, func(r TestingT) { SecretIsImmutable(r, client, "frozen", testNamespace, true) })
+	expectFail(t, func(r TestingT) { SecretIsImmutable(r, client, "frozen", testNamespace, false) })
+	expectFail(t, func(r TestingT) { SecretIsImmutable(r, client, "mutable", testNamespace, true) })
+}
+
+func TestEvents(t *testing.T) {
+	warning := &v1core.Event{
+		ObjectMeta:     testObjectMeta("web.1"),
+		InvolvedObject: v1core.ObjectReference{Name: "web", Namespace: testNamespace},
+		Type:           v1core.EventTypeWarning,
+		Reason:         "FailedScheduling",
+		Message:        "0/3 nodes are available",
+	}
+	normal := &v1core.Event{
+		ObjectMeta:     testObjectMeta("web.2"),
+		InvolvedObject: v1core.ObjectReference{Name: "web", Namespace: testNamespace},
+		Type:           v1core.EventTypeNormal,
+		Reason:         "Scheduled",
+	}
+	warned := fake.NewSimpleClientset(warning, normal)
+	clean := fake.NewSimpleClientset(normal)
+
+	expectPass(t, func(r TestingT) { ObjectHasWarningEvent(r, warned, testNamespace, "web") })
+	expectFail(t, func(r TestingT) { ObjectHasWarningEvent(r, clean, testNamespace, "web") })
+
+	expectPass(t, func(r TestingT) { NoWarningEvents(r, clean, testNamespace, "web") })
+	recorder := expectFail(t, func(r TestingT) { NoWarningEvents(r, warned, testNamespace, "web") })
+	expectMessageContains(t, recorder.errors, "FailedScheduling")
+
+	expectPass(t, func(r TestingT) { EventsForObjectContain(r, clean, testNamespace, "web", "Scheduled") })
+	expectFail(t, func(r TestingT) { EventsForObjectContain(r, clean, testNamespace, "web", "Pulled") })
+}
+
+func TestResourceQuotas(t *testing.T) {
+	quota := &v1core.ResourceQuota{
+		ObjectMeta: testObjectMeta("compute"),
+		Spec: v1core.ResourceQuotaSpec{
+			Hard: v1core.ResourceList{v1core.ResourceLimitsCPU: resource.MustParse("4")},
+		},
+		Status: v1core.ResourceQuotaStatus{
+			Hard: v1core.ResourceList{
+				v1core.ResourceLimitsCPU:    resource.MustParse("4"),
+				v1core.ResourceLimitsMemory: resource.MustParse("1Gi"),
+			},
+			Used: v1core.ResourceList{
+				v1core.ResourceLimitsCPU:    resource.MustParse("3500m"),
+				v1core.ResourceLimitsMemory: resource.MustParse("2Gi"),
+			},
+		},
+	}
+	pending := &v1core.ResourceQuota{
+		ObjectMeta: v1meta.ObjectMeta{Name: "pending", Namespace: testNamespace, CreationTimestamp: futureTimestamp()},
+	}
+	clientset := fake.NewSimpleClientset(quota, pending)
+	cpu := v1core.ResourceLimitsCPU
+
+	expectPass(t, func(r TestingT) { ResourceQuotaExists(r, clientset, "compute", testNamespace) })
+	expectFail(t, func(r TestingT) { ResourceQuotaExists(r, clientset, "pending", testNamespace) })
+
+	expectPass(t, func(r TestingT) {
+		ResourceQuotaHardLimit(r, clientset, "compute", testNamespace, cpu, resource.MustParse("4000m"))
+	})
+	expectFail(t, func(r TestingT) {
+		ResourceQuotaHardLimit(r, clientset, "compute", testNamespace, cpu, resource.MustParse("2"))
+	})
+	expectFail(t, func(r TestingT) {
+		ResourceQuotaHardLimit(r, clientset, "compute", testNamespace, v1core.ResourcePods, resource.MustParse("2"))
+	})
+
+	expectPass(t, func(r TestingT) { ResourceQuotaUsageWithinLimit(r, clientset, "compute", testNamespace, cpu) })
+	expectFail(t, func(r TestingT) {
+		ResourceQuotaUsageWithinLimit(r, clientset, "compute", testNamespace, v1core.ResourceLimitsMemory)
+	})
+}
+
+func TestLimitRanges(t *testing.T) {
+	limitRange := &v1core.LimitRange{
+		ObjectMeta: testObjectMeta("defaults"),
+		Spec: v1core.LimitRangeSpec{
+			Limits: []v1core.LimitRangeItem{
+				{
+					Type:           v1core.LimitTypeContainer,
+					Default:        v1core.ResourceList{v1core.ResourceCPU: resource.MustParse("500m")},
+					DefaultRequest: v1core.ResourceList{v1core.ResourceCPU: resource.MustParse("250m")},
+				},
+			},
+		},
+	}
+	pending := &v1core.LimitRange{
+		ObjectMeta: v1meta.ObjectMeta{Name: "pending", Namespace: testNamespace, CreationTimestamp: futureTimestamp()},
+	}
+	clientset := fake.NewSimpleClientset(limitRange, pending)
+	cpu := v1core.ResourceCPU
+
+	expectPass(t, func(r TestingT) { LimitRangeExists(r, clientset, "defaults", testNamespace) })
+	expectFail(t, func(r TestingT) { LimitRangeExists(r, clientset, "pending", testNamespace) })
+
+	expectPass(t, func(r TestingT) {
+		LimitRangeHasDefault(r, clientset, "defaults", testNamespace, cpu, resource.MustParse("0.5"))
+	})
+	expectFail(t, func(r TestingT) {
+		LimitRangeHasDefault(r, clientset, "defaults", testNamespace, cpu, resource.MustParse("250m"))
+	})
+	expectPass(t, func(r TestingT) {
+		LimitRangeHasDefaultRequest(r, clientset, "defaults", testNamespace, cpu, resource.MustParse("250m"))
+	})
+	expectFail(t, func(r TestingT) {
+		LimitRangeHasDefaultRequest(
+			r, clientset, "defaults", testNamespace, v1core.ResourceMemory, resource.MustParse("256Mi"),
+		)
+	})
+}
+
+func TestPodDisruptionBudgets(t *testing.T) {
+	minAvailable := intstr.FromInt(1)
+	pdb := &v1beta1policy.PodDisruptionBudget{
+		ObjectMeta: testObjectMeta("web"),
+		Spec:       v1beta1policy.PodDisruptionBudgetSpec{MinAvailable: &minAvailable},
+	}
+	pending := &v1beta1policy.PodDisruptionBudget{
+		ObjectMeta: v1meta.ObjectMeta{Name: "pending", Namespace: testNamespace, CreationTimestamp: futureTimestamp()},
+	}
+	clientset := fake.NewSimpleClientset(pdb, pending)
+
+	expectPass(t, func(r TestingT) { PodDisruptionBudgetExists(r, clientset, "web", testNamespace) })
+	expectFail(t, func(r TestingT) { PodDisruptionBudgetExists(r, clientset, "pending", testNamespace) })
+
+	expectPass(t, func(r TestingT) { PDBMinAvailable(r, clientset, "web", testNamespace, intstr.FromInt(1)) })
+	expectFail(t, func(r TestingT) { PDBMinAvailable(r, clientset, "web", testNamespace, intstr.FromString("50%")) })
+	expectFail(t, func(r TestingT) { PDBMinAvailable(r, clientset, "pending", testNamespace, intstr.FromInt(1)) })
+}
+
+func TestHorizontalPodAutoscalers(t *testing.T) {
+	hpa := &v1autoscaling.HorizontalPodAutoscaler{
+		ObjectMeta: testObjectMeta("web"),
+		Status:     v1autoscaling.HorizontalPodAutoscalerStatus{CurrentReplicas: 2, DesiredReplicas: 3},
+	}
+	clientset := fake.NewSimpleClientset(hpa)
+
+	expectPass(t, func(r TestingT) { HPACurrentReplicas(r, clientset, "web", testNamespace, 2) })
+	expectFail(t, func(r TestingT) { HPACurrentReplicas(r, clientset, "web", testNamespace, 3) })
+	expectPass(t, func(r TestingT) { HPADesiredReplicas(r, clientset, "web", testNamespace, 3) })
+	expectFail(t, func(r TestingT) { HPADesiredReplicas(r, clientset, "web", testNamespace, 2) })
+}
+
+func TestWebhookConfigurations(t *testing.T) {
+	ignore := v1admissionregistration.Ignore
+	mutating := &v1admissionregistration.MutatingWebhookConfiguration{
+		ObjectMeta: v1meta.ObjectMeta{Name: "injector"},
+		Webhooks: []v1admissionregistration.MutatingWebhook{
+			{
+				Name: "inject.example.com",
+				ClientConfig: v1admissionregistration.WebhookClientConfig{
+					Service: &v1admissionregistration.ServiceReference{Namespace: "system", Name: "injector"},
+				},
+				FailurePolicy: &ignore,
+			},
+		},
+	}
+	validating := &v1admissionregistration.ValidatingWebhookConfiguration{
+		ObjectMeta: v1meta.ObjectMeta{Name: "policy"},
+		Webhooks: []v1admissionregistration.ValidatingWebhook{
+			{
+				Name: "validate.example.com",
+				ClientConfig: v1admissionregistration.WebhookClientConfig{
+					URL: stringPointer("https://policy.example.com/validate"),
+				},
+			},
+		},
+	}
+	pendingMutating := &v1admissionregistration.MutatingWebhookConfiguration{
+		ObjectMeta: v1meta.ObjectMeta{Name: "pending", CreationTimestamp: futureTimestamp()},
+	}
+	pendingValidating := &v1admissionregistration.ValidatingWebhookConfiguration{
+		ObjectMeta: v1meta.ObjectMeta{Name: "pending", CreationTimestamp: futureTimestamp()},
+	}
+	clientset := fake.NewSimpleClientset(mutating, validating, pendingMutating, pendingValidating)
+
+	expectPass(t, func(r TestingT) { MutatingWebhookConfigurationExists(r, clientset, "injector") })
+	expectFail(t, func(r TestingT) { MutatingWebhookConfigurationExists(r, clientset, "pending") })
+	expectPass(t, func(r TestingT) { ValidatingWebhookConfigurationExists(r, clientset, "policy") })
+	expectFail(t, func(r TestingT) { ValidatingWebhookConfigurationExists(r, clientset, "pending") })
+
+	expectPass(t, func(r TestingT) {
+		WebhookTargetsService(r, clientset, "injector", "inject.example.com", "injector", "system")
+	})
+	expectFail(t, func(r TestingT) {
+		WebhookTargetsService(r, clientset, "injector", "inject.example.com", "injector", testNamespace)
+	})
+	expectFail(t, func(r TestingT) {
+		WebhookTargetsService(r, clientset, "policy", "validate.example.com", "policy", "system")
+	})
+
+	expectPass(t, func(r TestingT) {
+		WebhookFailurePolicy(r, clientset, "injector", "inject.example.com", v1admissionregistration.Ignore)
+	})
+	expectPass(t, func(r TestingT) {
+		WebhookFailurePolicy(r, clientset, "policy", "validate.example.com", v1admissionregistration.Fail)
+	})
+	expectFail(t, func(r TestingT) {
+		WebhookFailurePolicy(r, clientset, "policy", "validate.example.com", v1admissionregistration.Ignore)
+	})
+}
+
+func TestJobs(t *testing.T) {
+	configured := &v1batch.Job{
+		ObjectMeta: testObjectMeta("migrate"),
+		Spec:       v1batch.JobSpec{BackoffLimit: int32Pointer(2), Completions: int32Pointer(3)},
+	}
+	defaulted := &v1batch.Job{ObjectMeta: testObjectMeta("backup")}
+	clientset := fake.NewSimpleClientset(configured, defaulted)
+
+	expectPass(t, func(r TestingT) { JobBackoffLimit(r, clientset, "migrate", testNamespace, 2) })
+	expectPass(t, func(r TestingT) { JobBackoffLimit(r, clientset, "backup", testNamespace, 6) })
+	expectFail(t, func(r TestingT) { JobBackoffLimit(r, clientset, "backup", testNamespace, 2) })
+
+	expectPass(t, func(r TestingT) { JobCompletions(r, clientset, "migrate", testNamespace, 3) })
+	expectPass(t, func(r TestingT) { JobCompletions(r, clientset, "backup", testNamespace, 1) })
+	expectFail(t, func(r TestingT) { JobCompletions(r, clientset, "migrate", testNamespace, 1) })
+}
+
+func TestWaitForJobCompletion(t *testing.T) {
+	jobCondition := func(conditionType v1batch.JobConditionType) []v1batch.JobCondition {
+		return []v1batch.JobCondition{{Type: conditionType, Status: v1core.ConditionTrue}}
+	}
+	complete := &v1batch.Job{
+		ObjectMeta: testObjectMeta("complete"),
+		Status:     v1batch.JobStatus{Conditions: jobCondition(v1batch.JobComplete)},
+	}
+	failed := &v1batch.Job{
+		ObjectMeta: testObjectMeta("failed"),
+		Spec:       v1batch.JobSpec{Selector: &v1meta.LabelSelector{MatchLabels: map[string]string{"job": "failed"}}},
+		Status:     v1batch.JobStatus{Conditions: jobCondition(v1batch.JobFailed)},
+	}
+	running := &v1batch.Job{ObjectMeta: testObjectMeta("running")}
+	clientset := fake.NewSimpleClientset(complete, failed, running)
+
+	waitForJob := func(name string) func(r TestingT) {
+		return func(r TestingT) { WaitForJobCompletion(r, clientset, name, testNamespace, 3, time.Millisecond) }
+	}
+
+	expectPass(t, waitForJob("complete"))
+	expectFail(t, waitForJob("failed"))
+	expectFail(t, waitForJob("running"))
+}
+
+func TestCronJobs(t *testing.T) {
+	configured := &v1beta1batch.CronJob{
+		ObjectMeta: testObjectMeta("report"),
+		Spec: v1beta1batch.CronJobSpec{
+			ConcurrencyPolicy:          v1beta1batch.ForbidConcurrent,
+			SuccessfulJobsHistoryLimit: int32Pointer(5),
+			FailedJobsHistoryLimit:     int32Pointer(2),
+		},
+	}
+	defaulted := &v1beta1batch.CronJob{ObjectMeta: testObjectMeta("cleanup")}
+	clientset := fake.NewSimpleClientset(configured, defaulted)
+
+	expectPass(t, func(r TestingT) {
+		CronJobConcurrencyPolicy(r, clientset, "report", testNamespace, v1beta1batch.ForbidConcurrent)
+	})
+	expectFail(t, func(r TestingT) {
+		CronJobConcurrencyPolicy(r, clientset, "report", testNamespace, v1beta1batch.AllowConcurrent)
+	})
+
+	expectPass(t, func(r TestingT) { CronJobSuccessfulJobsHistoryLimit(r, clientset, "report", testNamespace, 5) })
+	expectPass(t, func(r TestingT) { CronJobSuccessfulJobsHistoryLimit(r, clientset, "cleanup", testNamespace, 3) })
+	expectFail(t, func(r TestingT) { CronJobSuccessfulJobsHistoryLimit(r, clientset, "cleanup", testNamespace, 5) })
+
+	expectPass(t, func(r TestingT) { CronJobFailedJobsHistoryLimit(r, clientset, "report", testNamespace, 2) })
+	expectPass(t, func(r TestingT) { CronJobFailedJobsHistoryLimit(r, clientset, "cleanup", testNamespace, 1) })
+	expectFail(t, func(r TestingT) { CronJobFailedJobsHistoryLimit(r, clientset, "cleanup", testNamespace, 2) })
+}
+
+func TestCRDEstablished(t *testing.T) {
+	crdCondition := func(status v1apiextensions.ConditionStatus) []v1apiextensions.CustomResourceDefinitionCondition {
+		return []v1apiextensions.CustomResourceDefinitionCondition{
+			{Type: v1apiextensions.Established, Status: status},
+		}
+	}
+	apiextClient := apiextensionsfake.NewSimpleClientset(
+		&v1apiextensions.CustomResourceDefinition{
+			ObjectMeta: v1meta.ObjectMeta{Name: "widgets.example.com"},
+			Status:     v1apiextensions.CustomResourceDefinitionStatus{Conditions: crdCondition("True")},
+		},
+		&v1apiextensions.CustomResourceDefinition{
+			ObjectMeta: v1meta.ObjectMeta{Name: "gadgets.example.com"},
+			Status:     v1apiextensions.CustomResourceDefinitionStatus{Conditions: crdCondition("False")},
+		},
+		&v1apiextensions.CustomResourceDefinition{ObjectMeta: v1meta.ObjectMeta{Name: "gizmos.example.com"}},
+	)
+
+	expectPass(t, func(r TestingT) { CRDEstablished(r, apiextClient, "widgets.example.com") })
+	expectFail(t, func(r TestingT) { CRDEstablished(r, apiextClient, "gadgets.example.com") })
+	expectFail(t, func(r TestingT) { CRDEstablished(r, apiextClient, "gizmos.example.com") })
+}
+
+func TestDynamicResources(t *testing.T) {
+	widgets := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+	pending := testUnstructured("example.com/v1", "Widget", "pending", testNamespace, nil)
+	pending.SetCreationTimestamp(futureTimestamp())
+	client := dynamicfake.NewSimpleDynamicClient(
+		runtime.NewScheme(),
+		testUnstructured("example.com/v1", "Widget", "web", testNamespace, map[string]interface{}{
+			"spec": map[string]interface{}{"size": int64(3), "color": "blue"},
+		}),
+		pending,
+	)
+
+	expectPass(t, func(r TestingT) { ResourceExists(r, client, widgets, "web", testNamespace) })
+	expectFail(t, func(r TestingT) { ResourceExists(r, client, widgets, "pending", testNamespace) })
+
+	fieldEquals := func(fieldPath string, expected interface{}) func(r TestingT) {
+		return func(r TestingT) { ResourceFieldEquals(r, client, widgets, "web", testNamespace, fieldPath, expected) }
+	}
+
+	expectPass(t, fieldEquals("spec.size", int64(3)))
+	expectPass(t, fieldEquals("spec.color", "blue"))
+	expectFail(t, fieldEquals("spec.size", 3))
+	expectFail(t, fieldEquals("spec.shape", ""))
+}
+
+func TestEndpointSliceReadyCount(t *testing.T) {
+	ready := v1beta1discovery.EndpointConditions{Ready: boolPointer(true)}
+	notReady := v1beta1discovery.EndpointConditions{Ready: boolPointer(false)}
+	endpointSlice := &v1beta1discovery.EndpointSlice{
+		ObjectMeta: v1meta.ObjectMeta{
+			Name:      "web-abc12",
+			Namespace: testNamespace,
+			Labels:    map[string]string{v1beta1discovery.LabelServiceName: "web"},
+		},
+		Endpoints: []v1beta1discovery.Endpoint{
+			{Addresses: []string{"10.0.0.1"}, Conditions: ready},
+			{Addresses: []string{"10.0.0.2"}, Conditions: notReady},
+			{Addresses: []string{"10.0.0.3"}},
+		},
+	}
+	clientset := fake.NewSimpleClientset(endpointSlice)
+
+	expectPass(t, func(r TestingT) { EndpointSliceReadyCount(r, clientset, "web", testNamespace, 2) })
+	expectFail(t, func(r TestingT) { EndpointSliceReadyCount(r, clientset, "web", testNamespace, 3) })
+	expectFail(t, func(r TestingT) { EndpointSliceReadyCount(r, clientset, "api", testNamespace, 1) })
+}
+
+func TestPriorityClasses(t *testing.T) {
+	pending := v1meta.ObjectMeta{Name: "pending", CreationTimestamp: futureTimestamp()}
+	clientset := fake.NewSimpleClientset(
+		&v1scheduling.PriorityClass{ObjectMeta: v1meta.ObjectMeta{Name: "critical"}, Value: 1000000},
+		&v1scheduling.PriorityClass{ObjectMeta: pending},
+	)
+
+	expectPass(t, func(r TestingT) { PriorityClassExists(r, clientset, "critical") })
+	expectFail(t, func(r TestingT) { PriorityClassExists(r, clientset, "pending") })
+	expectPass(t, func(r TestingT) { PriorityClassValueEqual(r, clientset, "critical", 1000000) })
+	expectFail(t, func(r TestingT) { PriorityClassValueEqual(r, clientset, "critical", 1000) })
+}
+
+func TestGetters(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		testDeployment(),
+		&v1.StatefulSet{ObjectMeta: testObjectMeta("web")},
+		&v1core.Service{ObjectMeta: testObjectMeta("web")},
+		testPod("web"),
+		&v1core.ConfigMap{ObjectMeta: testObjectMeta("web")},
+		&v1core.Secret{ObjectMeta: testObjectMeta("web")},
+	)
+	empty := fake.NewSimpleClientset()
+
+	getters := map[string]func(clientset kubernetes.Interface) (v1meta.Object, error){
+		"Deployment": func(clientset kubernetes.Interface) (v1meta.Object, error) {
+			return GetDeployment(clientset, "web", testNamespace)
+		},
+		"StatefulSet": func(clientset kubernetes.Interface) (v1meta.Object, error) {
+			return GetStatefulSet(clientset, "web", testNamespace)
+		},
+		"Service": func(clientset kubernetes.Interface) (v1meta.Object, error) {
+			return GetService(clientset, "web", testNamespace)
+		},
+		"Pod": func(clientset kubernetes.Interface) (v1meta.Object, error) {
+			return GetPod(clientset, "web", testNamespace)
+		},
+		"ConfigMap": func(clientset kubernetes.Interface) (v1meta.Object, error) {
+			return GetConfigMap(clientset, "web", testNamespace)
+		},
+		"Secret": func(clientset kubernetes.Interface) (v1meta.Object, error) {
+			return GetSecret(clientset, "web", testNamespace)
+		},
+	}
+
+	for kind, getter := range getters {
+		object, err := getter(clientset)
+		if err != nil || object.GetName() != "web" {
+			t.Errorf("Expected to get %v 'web', got %v.", kind, err)
+		}
+
+		if _, err := getter(empty); err == nil {
+			t.Errorf("Expected an error getting a missing %v, got nil.", kind)
+		}
+	}
+}
+
+func TestAssertResource(t *testing.T) {
+	clientset := fake.NewSimpleClientset(testDeployment())
+	getDeployment := func() (*v1.Deployment, error) { return GetDeployment(clientset, "web", testNamespace) }
+	getMissing := func() (*v1.Deployment, error) { return GetDeployment(clientset, "missing", testNamespace) }
+	hasReplicas := func(replicas int32) func(*v1.Deployment) (bool, string) {
+		return func(deployment *v1.Deployment) (bool, string) {
+			return *deployment.Spec.Replicas == replicas, fmt.Sprintf("Deployment has %v replicas.", replicas)
+		}
+	}
+
+	expectPass(t, func(r TestingT) { AssertResource(r, getDeployment, hasReplicas(2)) })
+	expectFail(t, func(r TestingT) { AssertResource(r, getDeployment, hasReplicas(3)) })
+	expectFail(t, func(r TestingT) { AssertResource(r, getMissing, hasReplicas(2)) })
+}
+
+func TestEventually(t *testing.T) {
+	attempts := 0
+	recorder := expectPass(t, func(r TestingT) {
+		Eventually(r, time.Second, time.Millisecond, func() error {
+			attempts++
+			if attempts < 3 {
+				return errors.New("not yet")
+			}
+
+			return nil
+		})
+	})
+
+	if attempts != 3 {
+		t.Errorf("Expected the assertion to be attempted 3 times, got %v.", attempts)
+	}
+
+	recorder = expectFail(t, func(r TestingT) {
+		Eventually(r, 20*time.Millisecond, 5*time.Millisecond, func() error { return errors.New("never ready") })
+	})
+	expectMessageContains(t, recorder.errors, "never ready")
+}
+
+func TestPollUntil(t *testing.T) {
+	attempts := 0
+	fetch := func() (int, error) {
+		attempts++
+		return attempts, nil
+	}
+
+	var result int
+	expectPass(t, func(r TestingT) {
+		result = PollUntil(r, 5, time.Millisecond, fetch, func(value int) bool { return value >= 3 })
+	})
+
+	if result != 3 {
+		t.Errorf("Expected the object from the third attempt, got %v.", result)
+	}
+
+	recorder := expectFail(t, func(r TestingT) {
+		PollUntil(r, 3, time.Millisecond, func() (int, error) { return 0, errors.New("unavailable") }, func(int) bool {
+			return true
+		})
+	})
+	expectMessageContains(t, recorder.fatals, "unavailable")
+}
+
+func TestWaitForExpectedPodCount(t *testing.T) {
+	clientset := fake.NewSimpleClientset(testPod("web-1"), testPod("web-2"))
+
+	expectPass(t, func(r TestingT) {
+		WaitForExpectedPodCount(r, clientset, testNamespace, "app=web", 2, 3, time.Millisecond)
+	})
+	expectFail(t, func(r TestingT) {
+		WaitForExpectedPodCount(r, clientset, testNamespace, "app=web", 3, 3, time.Millisecond)
+	})
+}
+
+func TestAllExist(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		&v1core.Namespace{ObjectMeta: v1meta.ObjectMeta{Name: testNamespace}},
+		testDeployment(),
+		&v1core.Service{ObjectMeta: testObjectMeta("web")},
+		&v1core.ConfigMap{ObjectMeta: testObjectMeta("web")},
+	)
+
+	expectPass(t, func(r TestingT) {
+		AllExist(r, clientset, []ExistenceCheck{
+			{Kind: "Namespace", Name: testNamespace},
+			{Kind: "Deployment", Name: "web", Namespace: testNamespace},
+			{Kind: "Service", Name: "web", Namespace: testNamespace},
+			{Kind: "ConfigMap", Name: "web", Namespace: testNamespace},
+		})
+	})
+
+	recorder := expectFail(t, func(r TestingT) {
+		AllExist(r, clientset, []ExistenceCheck{
+			{Kind: "Deployment", Name: "web", Namespace: testNamespace},
+			{Kind: "Secret", Name: "missing", Namespace: testNamespace},
+			{Kind: "Widget", Name: "web", Namespace: testNamespace},
+		})
+	})
+	expectMessageContains(t, recorder.errors, "2 of 3")
+
+	if len(recorder.errors) == 1 {
+		message := recorder.errors[0]
+		if strings.Index(message, "Secret 'missing'") > strings.Index(message, "Widget 'web'") {
+			t.Errorf("Expected missing objects in the order they were checked, got '%v'.", message)
+		}
+	}
+}
+
+func TestRunChecks(t *testing.T) {
+	ran := make([]string, 0)
+
+	RunChecks(t, []Check{
+		{Name: "first", Assert: func(t *testing.T) { ran = append(ran, t.Name()) }},
+		{Name: "second", Assert: func(t *testing.T) { ran = append(ran, t.Name()) }},
+	})
+
+	expected := []string{"TestRunChecks/first", "TestRunChecks/second"}
+	if strings.Join(ran, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected checks to run as subtests %v, got %v.", expected, ran)
+	}
+}
+
+// TestPodLogContains serves pod logs from a test server, since the fake clientset can't stream logs.
+func TestPodLogContains(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/namespaces/default/pods/web-1/log" || r.URL.Query().Get("container") != "web" {
+			http.NotFound(w, r)
+			return
+		}
+
+		_, _ = w.Write([]byte("Listening on port 8080\n"))
+	}))
+	defer server.Close()
+
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+
+	if err != nil {
+		t.Fatalf("Failed to create a clientset for the test server: %v.", err)
+	}
+
+	expectPass(t, func(r TestingT) {
+		PodLogContains(r, clientset, testNamespace, "web-1", "web", "Listening on port 8080")
+	})
+	expectFail(t, func(r TestingT) {
+		PodLogContains(r, clientset, testNamespace, "web-1", "web", "panic")
+	})
+	expectPanic(t, func(r TestingT) {
+		PodLogContains(r, clientset, testNamespace, "web-2", "web", "Listening on port 8080")
+	})
+}